@@ -1,6 +1,7 @@
 package icu
 
 import (
+	"errors"
 	"path/filepath"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/paketo-buildpacks/packit/v2/postal"
 	"github.com/paketo-buildpacks/packit/v2/sbom"
 	"github.com/paketo-buildpacks/packit/v2/scribe"
+	"github.com/paketo-buildpacks/packit/v2/servicebindings"
 )
 
 //go:generate faux --interface DependencyManager --output fakes/dependency_manager.go
@@ -25,12 +27,26 @@ type SBOMGenerator interface {
 	GenerateFromDependency(dependency postal.Dependency, dir string) (sbom.SBOM, error)
 }
 
+//go:generate faux --interface BindingResolver --output fakes/binding_resolver.go
+type BindingResolver interface {
+	Resolve(typ, provider, platformDir string) ([]servicebindings.Binding, error)
+}
+
+//go:generate faux --interface DependencyVerifier --output fakes/dependency_verifier.go
+type DependencyVerifier interface {
+	Fetch(dependency postal.Dependency, signatureURI string, keyring []byte, destination string) (string, error)
+}
+
 func Build(dependencyManager DependencyManager,
 	sbomGenerator SBOMGenerator,
+	bindingResolver BindingResolver,
+	dependencyVerifier DependencyVerifier,
+	layerPatcher LayerPatcher,
+	icupkg Executable,
 	clock chronos.Clock,
 	logger scribe.Emitter,
 ) packit.BuildFunc {
-	return func(context packit.BuildContext) (packit.BuildResult, error) {
+	return func(context packit.BuildContext) (result packit.BuildResult, err error) {
 		logger.Title("%s %s", context.BuildpackInfo.Name, context.BuildpackInfo.Version)
 		logger.Process("Resolving ICU version")
 
@@ -38,19 +54,111 @@ func Build(dependencyManager DependencyManager,
 		entry, allEntries := planner.Resolve("icu", context.Plan.Entries, nil)
 		logger.Candidates(allEntries)
 
-		version, _ := entry.Metadata["version"].(string)
-		if version == "" {
-			version = "*"
+		reason, err := disabledBy()
+		if err != nil {
+			return packit.BuildResult{}, err
 		}
 
-		dependency, err := dependencyManager.Resolve(filepath.Join(context.CNBPath, "buildpack.toml"), entry.Name, version, context.Stack)
+		if reason != "" {
+			warnDisabled(logger, reason, allEntries)
+
+			for _, name := range []string{ICULayerName, ICUStaticLayerName, ICUDebugLayerName, ICUOverridesLayerName, ICUCacheLayerName} {
+				err = removeLayer(context.Layers, name)
+				if err != nil {
+					return packit.BuildResult{}, err
+				}
+			}
+
+			return packit.BuildResult{}, nil
+		}
+
+		var (
+			dependency postal.Dependency
+			custom     bool
+		)
+		resolveDuration, err := clock.Measure(func() error {
+			var err error
+			dependency, custom, err = customDependency()
+			if err != nil || custom {
+				return err
+			}
+
+			version, _ := entry.Metadata["version"].(string)
+			if version == "" {
+				version = "*"
+			}
+
+			dependency, err = dependencyManager.Resolve(filepath.Join(context.CNBPath, "buildpack.toml"), entry.Name, version, context.Stack)
+			if err != nil {
+				return err
+			}
+
+			dependency.Name = "ICU"
+			return nil
+		})
 		if err != nil {
 			return packit.BuildResult{}, err
 		}
 
-		dependency.Name = "ICU"
+		if custom {
+			entry.Metadata = map[string]interface{}{"version-source": "BP_ICU_DEPENDENCY_URI"}
+		}
+
+		report := newBuildReport(allEntries, dependency, custom)
+		report.Durations.Resolve = resolveDuration.Seconds()
+
 		logger.SelectedDependency(entry, dependency, clock.Now())
 
+		err = enforcePolicy(bindingResolver, logger, filepath.Join(context.CNBPath, "buildpack.toml"), context.Platform.Path, dependency, clock.Now())
+		if err != nil {
+			return packit.BuildResult{}, err
+		}
+
+		nodeData, err := nodeDataRequested(context.Plan.Entries)
+		if err != nil {
+			return packit.BuildResult{}, err
+		}
+
+		debugSymbols, err := debugSymbolsRequested()
+		if err != nil {
+			return packit.BuildResult{}, err
+		}
+
+		static := staticRequested(context.Plan.Entries)
+
+		// the static libraries and debug symbols are only published for the
+		// versions in buildpack.toml, so they cannot match a custom dependency
+		if custom && (static || debugSymbols) {
+			return packit.BuildResult{}, errors.New("failed to install ICU: static libraries and debug symbols are not available for a custom dependency set with BP_ICU_DEPENDENCY_URI")
+		}
+
+		size, err := cacheSize()
+		if err != nil {
+			return packit.BuildResult{}, err
+		}
+
+		timestamp, err := sourceDateEpoch()
+		if err != nil {
+			return packit.BuildResult{}, err
+		}
+
+		cache, err := openDependencyCache(context.Layers, size, clock.Now)
+		if err != nil {
+			return packit.BuildResult{}, err
+		}
+
+		// layers are replaced in a transaction so that a failed build leaves the
+		// layers of the previous build in place
+		transaction := layerTransaction{timestamp: timestamp}
+		defer func() {
+			if err != nil {
+				err = errors.Join(err, transaction.Rollback(context.Layers))
+				return
+			}
+
+			err = transaction.Close()
+		}()
+
 		layer, err := context.Layers.Get(ICULayerName)
 		if err != nil {
 			return packit.BuildResult{}, err
@@ -70,65 +178,203 @@ func Build(dependencyManager DependencyManager,
 		}
 
 		cachedChecksum, ok := layer.Metadata["dependency-checksum"].(string)
-		if ok && cargo.Checksum(dependency.Checksum).MatchString(cachedChecksum) {
+		cachedNodeData, _ := layer.Metadata["node-data"].(bool)
+		if ok && cargo.Checksum(dependency.Checksum).MatchString(cachedChecksum) && cachedNodeData == nodeData {
 			logger.Process("Reusing cached layer %s", layer.Path)
 			logger.Break()
 
 			layer.Launch, layer.Build, layer.Cache = launch, build, build
 
-			return packit.BuildResult{
-				Layers: []packit.Layer{layer},
-				Build:  buildMetadata,
-				Launch: launchMetadata,
-			}, nil
+			report.Layer.Reused = true
+			report.Layer.Reason = reasonChecksumMatch
+			report.Layer.InstalledSize, _ = layer.Metadata["installed-size"].(int64)
+
+			cache.Touch(dependency.Checksum)
+		} else {
+			logger.Process("Executing build process")
+
+			switch {
+			case !ok:
+				report.Layer.Reason = reasonNoPreviousLayer
+			case !cargo.Checksum(dependency.Checksum).MatchString(cachedChecksum):
+				report.Layer.Reason = reasonChecksumChanged
+			default:
+				report.Layer.Reason = reasonNodeDataChanged
+			}
+
+			var stagingPath string
+			layer, stagingPath, err = transaction.Stage(layer)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
+
+			layer.Launch, layer.Build, layer.Cache = launch, build, build
+
+			logger.Subprocess("Installing ICU")
+
+			var restored bool
+			duration, err := clock.Measure(func() error {
+				var err error
+				restored, err = cache.Restore(dependency.Checksum, stagingPath)
+				if err != nil || restored {
+					report.Layer.InstalledFrom = "cache"
+					return err
+				}
+
+				if !custom && cachedChecksum != "" && cachedChecksum != dependency.Checksum {
+					patched, err := patchFromPrevious(context, dependencyManager, layerPatcher, &cache, logger, cachedChecksum, dependency, layer.Path, stagingPath)
+					if err != nil || patched {
+						report.Layer.InstalledFrom = "patch"
+						return err
+					}
+				}
+
+				report.Layer.InstalledFrom = "download"
+
+				if custom {
+					return deliverCustomDependency(dependencyManager, dependencyVerifier, bindingResolver, logger, dependency, stagingPath, context.Platform.Path)
+				}
+
+				return dependencyManager.Deliver(dependency, context.CNBPath, stagingPath, context.Platform.Path)
+			})
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
+
+			if restored {
+				logger.Action("Restored ICU %s from %s", dependency.Version, cache.Layer().Path)
+			} else {
+				err = cache.Store(dependency.Checksum, dependency.Version, stagingPath)
+				if err != nil {
+					return packit.BuildResult{}, err
+				}
+			}
+
+			var nodeDataPath string
+			if nodeData {
+				var generated bool
+				nodeDataPath, generated, err = nodeDataDirectory(icupkg, stagingPath, dependency.Version)
+				if err != nil {
+					return packit.BuildResult{}, err
+				}
+
+				if generated {
+					logger.Action("Generated Node.js ICU data with icupkg")
+				}
+			}
+
+			err = transaction.Commit(layer)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
+
+			logger.Action("Completed in %s", duration.Round(time.Millisecond))
+			logger.Break()
+
+			report.Durations.Deliver = duration.Seconds()
+
+			if nodeData {
+				layer.LaunchEnv.Default("NODE_ICU_DATA", filepath.Join(layer.Path, nodeDataPath))
+				logger.EnvironmentVariables(layer)
+			}
+
+			report.Layer.InstalledSize, err = installedSize(layer.Path)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
+
+			logger.GeneratingSBOM(layer.Path)
+			var sbomContent sbom.SBOM
+			duration, err = clock.Measure(func() error {
+				sbomContent, err = sbomGenerator.GenerateFromDependency(dependency, layer.Path)
+				return err
+			})
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
+
+			logger.Action("Completed in %s", duration.Round(time.Millisecond))
+			logger.Break()
+
+			report.Durations.SBOM = duration.Seconds()
+
+			logger.FormattingSBOM(context.BuildpackInfo.SBOMFormats...)
+			layer.SBOM, err = sbomContent.InFormats(context.BuildpackInfo.SBOMFormats...)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
+
+			layer.Metadata = map[string]interface{}{
+				"dependency-checksum": dependency.Checksum,
+				"installed-size":      report.Layer.InstalledSize,
+			}
+
+			if nodeData {
+				layer.Metadata["node-data"] = true
+			}
 		}
 
-		logger.Process("Executing build process")
+		if nodeData {
+			warnNodeICUVersion(context.Plan.Entries, logger, dependency.Version)
+		}
 
-		layer, err = layer.Reset()
+		layers := []packit.Layer{layer}
+
+		overridesLayer, ok, err := installOverrides(context, &transaction, bindingResolver, logger, dependency.Version, launch, build)
 		if err != nil {
 			return packit.BuildResult{}, err
 		}
 
-		layer.Launch, layer.Build, layer.Cache = launch, build, build
+		if ok {
+			layers = append(layers, overridesLayer)
+		}
 
-		logger.Subprocess("Installing ICU")
+		if static {
+			staticLayer, staticBOM, err := installCompanion(context, &transaction, dependencyManager, sbomGenerator, clock, logger, staticCompanion(logger), dependency.Version)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
 
-		duration, err := clock.Measure(func() error {
-			return dependencyManager.Deliver(dependency, context.CNBPath, layer.Path, context.Platform.Path)
-		})
-		if err != nil {
-			return packit.BuildResult{}, err
+			layers = append(layers, staticLayer)
+			buildMetadata.BOM = append(buildMetadata.BOM, staticBOM...)
+		} else {
+			err = removeLayer(context.Layers, ICUStaticLayerName)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
 		}
 
-		logger.Action("Completed in %s", duration.Round(time.Millisecond))
-		logger.Break()
+		if debugSymbols {
+			debugLayer, debugBOM, err := installCompanion(context, &transaction, dependencyManager, sbomGenerator, clock, logger, debugSymbolsCompanion(logger), dependency.Version)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
 
-		logger.GeneratingSBOM(layer.Path)
-		var sbomContent sbom.SBOM
-		duration, err = clock.Measure(func() error {
-			sbomContent, err = sbomGenerator.GenerateFromDependency(dependency, layer.Path)
-			return err
-		})
-		if err != nil {
-			return packit.BuildResult{}, err
+			layers = append(layers, debugLayer)
+			launchMetadata.BOM = append(launchMetadata.BOM, debugBOM...)
+		} else {
+			err = removeLayer(context.Layers, ICUDebugLayerName)
+			if err != nil {
+				return packit.BuildResult{}, err
+			}
 		}
 
-		logger.Action("Completed in %s", duration.Round(time.Millisecond))
-		logger.Break()
+		if cache.Enabled() {
+			layers = append(layers, cache.Layer())
+		}
 
-		logger.FormattingSBOM(context.BuildpackInfo.SBOMFormats...)
-		layer.SBOM, err = sbomContent.InFormats(context.BuildpackInfo.SBOMFormats...)
+		reportPath, ok, err := writeReport(context, report)
 		if err != nil {
 			return packit.BuildResult{}, err
 		}
 
-		layer.Metadata = map[string]interface{}{
-			"dependency-checksum": dependency.Checksum,
+		if ok {
+			logger.Process("Wrote build report to %s", reportPath)
+			logger.Break()
 		}
 
 		return packit.BuildResult{
-			Layers: []packit.Layer{layer},
+			Layers: layers,
 			Build:  buildMetadata,
 			Launch: launchMetadata,
 		}, nil
@@ -2,12 +2,17 @@ package icu_test
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/paketo-buildpacks/icu"
 	"github.com/paketo-buildpacks/icu/fakes"
@@ -17,8 +22,10 @@ import (
 
 	//nolint Ignore SA1019, informed usage of deprecated package
 	"github.com/paketo-buildpacks/packit/v2/paketosbom"
+	"github.com/paketo-buildpacks/packit/v2/pexec"
 	"github.com/paketo-buildpacks/packit/v2/postal"
 	"github.com/paketo-buildpacks/packit/v2/scribe"
+	"github.com/paketo-buildpacks/packit/v2/servicebindings"
 	"github.com/sclevine/spec"
 
 	. "github.com/onsi/gomega"
@@ -34,6 +41,10 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 
 		dependencyManager *fakes.DependencyManager
 		sbomGenerator     *fakes.SBOMGenerator
+		bindingResolver   *fakes.BindingResolver
+		verifier          *fakes.DependencyVerifier
+		layerPatcher      *fakes.LayerPatcher
+		icupkg            *fakes.Executable
 
 		buffer *bytes.Buffer
 
@@ -100,9 +111,23 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 		sbomGenerator = &fakes.SBOMGenerator{}
 		sbomGenerator.GenerateFromDependencyCall.Returns.SBOM = sbom.SBOM{}
 
+		bindingResolver = &fakes.BindingResolver{}
+
+		verifier = &fakes.DependencyVerifier{}
+		verifier.FetchCall.Stub = func(dependency postal.Dependency, signatureURI string, keyring []byte, destination string) (string, error) {
+			return filepath.Join(destination, "icu.tgz"), nil
+		}
+
+		layerPatcher = &fakes.LayerPatcher{}
+		icupkg = &fakes.Executable{}
+
 		build = icu.Build(
 			dependencyManager,
 			sbomGenerator,
+			bindingResolver,
+			verifier,
+			layerPatcher,
+			icupkg,
 			chronos.DefaultClock,
 			scribe.NewEmitter(buffer))
 	})
@@ -124,12 +149,15 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 		Expect(layer.Path).To(Equal(filepath.Join(layersDir, "icu")))
 		Expect(layer.Metadata).To(Equal(map[string]interface{}{
 			"dependency-checksum": "icu-dependency-sha",
+			"installed-size":      int64(0),
 		}))
 
 		Expect(layer.Build).To(BeFalse())
 		Expect(layer.Launch).To(BeFalse())
 		Expect(layer.Cache).To(BeFalse())
 
+		Expect(filepath.Join(layersDir, "icu-cache")).NotTo(BeADirectory())
+
 		Expect(layer.SBOM.Formats()).To(HaveLen(2))
 		cdx := layer.SBOM.Formats()[0]
 		spdx := layer.SBOM.Formats()[1]
@@ -223,7 +251,7 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 			Version:  "icu-dependency-version",
 		}))
 		Expect(dependencyManager.DeliverCall.Receives.CnbPath).To(Equal(cnbDir))
-		Expect(dependencyManager.DeliverCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, "icu")))
+		Expect(dependencyManager.DeliverCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, ".icu.staging")))
 		Expect(dependencyManager.DeliverCall.Receives.PlatformPath).To(Equal("platform"))
 
 		Expect(sbomGenerator.GenerateFromDependencyCall.Receives.Dependency).To(Equal(postal.Dependency{
@@ -270,6 +298,7 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 			Expect(layer.Path).To(Equal(filepath.Join(layersDir, "icu")))
 			Expect(layer.Metadata).To(Equal(map[string]interface{}{
 				"dependency-checksum": "icu-dependency-sha",
+				"installed-size":      int64(0),
 			}))
 			Expect(dependencyManager.ResolveCall.Receives.Path).To(Equal(filepath.Join(cnbDir, "buildpack.toml")))
 			Expect(dependencyManager.ResolveCall.Receives.Id).To(Equal("icu"))
@@ -297,6 +326,7 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 			Expect(layer.Path).To(Equal(filepath.Join(layersDir, "icu")))
 			Expect(layer.Metadata).To(Equal(map[string]interface{}{
 				"dependency-checksum": "icu-dependency-sha",
+				"installed-size":      int64(0),
 			}))
 
 			Expect(layer.Build).To(BeTrue())
@@ -376,6 +406,1867 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 		})
 	})
 
+	context("when the plan entry requests Node.js ICU data", func() {
+		// deliverLibicudata delivers only the libicudata fixture, which embeds
+		// "icu-common-data" as the icudt78_dat symbol, under the given version
+		deliverLibicudata := func(version string) func(postal.Dependency, string, string, string) error {
+			return func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				content, err := os.ReadFile(filepath.Join("testdata", "libicudata.so.78.3"))
+				if err != nil {
+					return err
+				}
+
+				err = os.MkdirAll(filepath.Join(layerPath, "lib"), os.ModePerm)
+				if err != nil {
+					return err
+				}
+
+				return os.WriteFile(filepath.Join(layerPath, "lib", fmt.Sprintf("libicudata.so.%s", version)), content, 0644)
+			}
+		}
+
+		it.Before(func() {
+			dependencyManager.ResolveCall.Returns.Dependency.Version = "78.3"
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				err := os.MkdirAll(filepath.Join(layerPath, "share", "icu", "78.3"), os.ModePerm)
+				if err != nil {
+					return err
+				}
+
+				return os.WriteFile(filepath.Join(layerPath, "share", "icu", "78.3", "icudt78l.dat"), []byte("data"), 0644)
+			}
+
+			buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+				"launch":    true,
+				"node-data": true,
+			}
+		})
+
+		it("sets NODE_ICU_DATA to the delivered data file", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(1))
+			layer := result.Layers[0]
+
+			Expect(layer.Metadata).To(Equal(map[string]interface{}{
+				"dependency-checksum": "icu-dependency-sha",
+				"installed-size":      int64(4),
+				"node-data":           true,
+			}))
+			Expect(layer.LaunchEnv).To(Equal(packit.Environment{
+				"NODE_ICU_DATA.default": filepath.Join(layersDir, "icu", "share", "icu", "78.3"),
+			}))
+
+			Expect(filepath.Join(layersDir, "icu", "share", "icu", "78.3", "icudt78l.dat")).To(BeARegularFile())
+		})
+
+		context("when the dependency does not ship the data file", func() {
+			var source []byte
+
+			it.Before(func() {
+				dependencyManager.DeliverCall.Stub = deliverLibicudata("78.3")
+
+				icupkg.ExecuteCall.Stub = func(execution pexec.Execution) error {
+					var err error
+					source, err = os.ReadFile(execution.Args[2])
+					if err != nil {
+						return err
+					}
+
+					return os.WriteFile(execution.Args[3], []byte("little-endian-data"), 0644)
+				}
+			})
+
+			it("generates it from libicudata with icupkg", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(icupkg.ExecuteCall.CallCount).To(Equal(1))
+				args := icupkg.ExecuteCall.Receives.Execution.Args
+				Expect(args).To(HaveLen(4))
+				Expect(args[:2]).To(Equal([]string{"--type", "l"}))
+				Expect(filepath.Base(args[2])).To(Equal("icudt78l.dat"))
+				Expect(args[3]).To(HaveSuffix(filepath.Join("share", "icu", "78.3", "icudt78l.dat")))
+				Expect(icupkg.ExecuteCall.Receives.Execution.Env).To(ContainElement(HavePrefix("LD_LIBRARY_PATH=")))
+
+				Expect(source).To(Equal([]byte("icu-common-data\x00")))
+
+				Expect(result.Layers[0].LaunchEnv).To(Equal(packit.Environment{
+					"NODE_ICU_DATA.default": filepath.Join(layersDir, "icu", "share", "icu", "78.3"),
+				}))
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu", "share", "icu", "78.3", "icudt78l.dat"))).To(Equal([]byte("little-endian-data")))
+
+				Expect(buffer.String()).To(ContainSubstring("Generated Node.js ICU data with icupkg"))
+			})
+		})
+
+		context("when the plan entry gives the ICU version of the Node.js runtime", func() {
+			it.Before(func() {
+				buildContext.Plan.Entries[0].Metadata["node-icu-version"] = "77.1"
+			})
+
+			it("warns that the ICU major version differs", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buffer.String()).To(ContainSubstring("WARNING: Node.js expects ICU 77 data but ICU 78 is being installed"))
+				Expect(buffer.String()).To(ContainSubstring("Node.js will ignore NODE_ICU_DATA; request an ICU version matching 77.*"))
+			})
+
+			context("when the cached layer is reused", func() {
+				it.Before(func() {
+					err := os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+						[]byte("[metadata]\ndependency-checksum = \"icu-dependency-sha\"\nnode-data = true\n"), 0600)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				it("still warns", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+					Expect(buffer.String()).To(ContainSubstring("WARNING: Node.js expects ICU 77 data but ICU 78 is being installed"))
+				})
+			})
+
+			context("when the major versions match", func() {
+				it.Before(func() {
+					buildContext.Plan.Entries[0].Metadata["node-icu-version"] = "78.1"
+				})
+
+				it("does not warn", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.String()).NotTo(ContainSubstring("WARNING: Node.js expects"))
+				})
+			})
+		})
+
+		context("when the cached layer was built without Node.js ICU data", func() {
+			it.Before(func() {
+				err := os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"icu-dependency-sha\"\n"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			it("rebuilds the layer", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+				Expect(result.Layers[0].LaunchEnv).To(HaveKey("NODE_ICU_DATA.default"))
+			})
+		})
+
+		context("when the cached layer already includes Node.js ICU data", func() {
+			it.Before(func() {
+				err := os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"icu-dependency-sha\"\nnode-data = true\n"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			it("reuses the layer", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+			})
+		})
+
+		context("when BP_ICU_NODE_DATA is set", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_NODE_DATA", "true")
+				buildContext.Plan.Entries[0].Metadata = nil
+			})
+
+			it("sets NODE_ICU_DATA", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers[0].LaunchEnv).To(HaveKey("NODE_ICU_DATA.default"))
+			})
+		}, spec.Sequential())
+
+		context("failure cases", func() {
+			context("when BP_ICU_NODE_DATA cannot be parsed", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_NODE_DATA", "not-a-bool")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(ContainSubstring(`failed to parse BP_ICU_NODE_DATA value "not-a-bool"`)))
+				})
+			}, spec.Sequential())
+
+			context("when the dependency includes neither the data file nor libicudata", func() {
+				it.Before(func() {
+					dependencyManager.DeliverCall.Stub = nil
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to configure Node.js ICU data: the ICU dependency includes neither share/icu/78.3/icudt78l.dat nor lib/libicudata.so.78.3"))
+				})
+			})
+
+			context("when libicudata does not embed the common data", func() {
+				it.Before(func() {
+					dependencyManager.DeliverCall.Stub = deliverLibicudata("77.3")
+					dependencyManager.ResolveCall.Returns.Dependency.Version = "77.3"
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to configure Node.js ICU data: libicudata.so.77.3 does not define icudt77_dat"))
+				})
+			})
+
+			context("when icupkg fails", func() {
+				it.Before(func() {
+					dependencyManager.DeliverCall.Stub = deliverLibicudata("78.3")
+					icupkg.ExecuteCall.Stub = func(execution pexec.Execution) error {
+						fmt.Fprintln(execution.Stderr, "icupkg: unable to load the common data")
+						return errors.New("exit status 1")
+					}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(ContainSubstring("failed to generate Node.js ICU data: exit status 1")))
+					Expect(err).To(MatchError(ContainSubstring("icupkg: unable to load the common data")))
+				})
+			})
+		})
+	})
+
+	context("when the plan entry requests static libraries", func() {
+		it.Before(func() {
+			dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+				if id == "icu-static" {
+					return postal.Dependency{
+						ID:       "icu-static",
+						Checksum: "icu-static-dependency-sha",
+						Stacks:   []string{"some-stack"},
+						URI:      "icu-static-dependency-uri",
+						Version:  "icu-dependency-version",
+					}, nil
+				}
+
+				return postal.Dependency{
+					ID:       "icu",
+					Checksum: "icu-dependency-sha",
+					Stacks:   []string{"some-stack"},
+					URI:      "icu-dependency-uri",
+					Version:  "icu-dependency-version",
+				}, nil
+			}
+
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				if dependency.ID != "icu-static" {
+					return nil
+				}
+
+				err := os.MkdirAll(filepath.Join(layerPath, "lib", "pkgconfig"), os.ModePerm)
+				if err != nil {
+					return err
+				}
+
+				return os.WriteFile(filepath.Join(layerPath, "lib", "pkgconfig", "icu-uc.pc"), []byte("prefix = /tmp/tmp.build\nlibdir = ${prefix}/lib\n"), 0644)
+			}
+
+			buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+				"build":  true,
+				"static": true,
+			}
+		})
+
+		it("installs the static libraries into a build-only layer", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(2))
+			layer := result.Layers[1]
+
+			Expect(layer.Name).To(Equal("icu-static"))
+			Expect(layer.Path).To(Equal(filepath.Join(layersDir, "icu-static")))
+			Expect(layer.Metadata).To(Equal(map[string]interface{}{
+				"dependency-checksum": "icu-static-dependency-sha",
+			}))
+
+			Expect(layer.Build).To(BeTrue())
+			Expect(layer.Launch).To(BeFalse())
+			Expect(layer.Cache).To(BeTrue())
+
+			Expect(layer.BuildEnv).To(Equal(packit.Environment{
+				"PKG_CONFIG_PATH.prepend": filepath.Join(layersDir, "icu-static", "lib", "pkgconfig"),
+				"PKG_CONFIG_PATH.delim":   ":",
+			}))
+			Expect(layer.SBOM.Formats()).To(HaveLen(2))
+
+			content, err := os.ReadFile(filepath.Join(layersDir, "icu-static", "lib", "pkgconfig", "icu-uc.pc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(fmt.Sprintf("prefix = %s\nlibdir = ${prefix}/lib\n", filepath.Join(layersDir, "icu-static"))))
+
+			info, err := os.Stat(filepath.Join(layersDir, "icu-static", "lib", "pkgconfig", "icu-uc.pc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.ModTime().UTC()).To(Equal(time.Date(1980, time.January, 1, 0, 0, 1, 0, time.UTC)))
+
+			Expect(dependencyManager.ResolveCall.CallCount).To(Equal(2))
+			Expect(dependencyManager.ResolveCall.Receives.Id).To(Equal("icu-static"))
+			Expect(dependencyManager.ResolveCall.Receives.Version).To(Equal("icu-dependency-version"))
+
+			Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu-static"))
+			Expect(dependencyManager.DeliverCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, ".icu-static.staging")))
+
+			Expect(sbomGenerator.GenerateFromDependencyCall.Receives.Dependency.Name).To(Equal("ICU (static)"))
+			Expect(sbomGenerator.GenerateFromDependencyCall.Receives.Dir).To(Equal(filepath.Join(layersDir, "icu-static")))
+
+			Expect(result.Build.BOM).To(HaveLen(2))
+			Expect(dependencyManager.GenerateBillOfMaterialsCall.Receives.Dependencies[0].ID).To(Equal("icu-static"))
+		})
+
+		context("when there is a cache match for the static layer", func() {
+			it.Before(func() {
+				err := os.WriteFile(filepath.Join(layersDir, "icu-static.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"icu-static-dependency-sha\"\n"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			it("reuses the layer", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers).To(HaveLen(2))
+				Expect(result.Layers[1].Build).To(BeTrue())
+				Expect(result.Layers[1].Cache).To(BeTrue())
+
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+				Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu"))
+			})
+		})
+
+		context("failure cases", func() {
+			context("when the static dependency cannot be resolved", func() {
+				it.Before(func() {
+					stub := dependencyManager.ResolveCall.Stub
+					dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+						if id == "icu-static" {
+							return postal.Dependency{}, errors.New("failed to resolve static dependency")
+						}
+
+						return stub(path, id, version, stack)
+					}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to resolve static dependency"))
+				})
+			})
+
+			context("when the static dependency cannot be delivered", func() {
+				it.Before(func() {
+					dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+						if dependency.ID == "icu-static" {
+							return errors.New("failed to deliver static dependency")
+						}
+
+						return nil
+					}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to deliver static dependency"))
+				})
+			})
+		})
+	})
+
+	context("when BP_ICU_DEBUG_SYMBOLS is set", func() {
+		it.Before(func() {
+			t.Setenv("BP_ICU_DEBUG_SYMBOLS", "true")
+
+			dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+				return postal.Dependency{
+					ID:       id,
+					Checksum: fmt.Sprintf("%s-dependency-sha", id),
+					Stacks:   []string{"some-stack"},
+					URI:      fmt.Sprintf("%s-dependency-uri", id),
+					Version:  "icu-dependency-version",
+				}, nil
+			}
+
+			buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+				"launch": true,
+			}
+		})
+
+		it("installs the debug symbols into a launch layer", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(2))
+			layer := result.Layers[1]
+
+			Expect(layer.Name).To(Equal("icu-debug"))
+			Expect(layer.Path).To(Equal(filepath.Join(layersDir, "icu-debug")))
+			Expect(layer.Metadata).To(Equal(map[string]interface{}{
+				"dependency-checksum": "icu-debug-dependency-sha",
+			}))
+
+			Expect(layer.Build).To(BeFalse())
+			Expect(layer.Launch).To(BeTrue())
+			Expect(layer.Cache).To(BeTrue())
+			Expect(layer.SBOM.Formats()).To(HaveLen(2))
+
+			Expect(dependencyManager.ResolveCall.Receives.Id).To(Equal("icu-debug"))
+			Expect(dependencyManager.ResolveCall.Receives.Version).To(Equal("icu-dependency-version"))
+			Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu-debug"))
+			Expect(dependencyManager.DeliverCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, ".icu-debug.staging")))
+
+			Expect(result.Launch.BOM).To(HaveLen(2))
+
+			Expect(buffer.String()).To(ContainSubstring(fmt.Sprintf("Debug symbols are available in %s", filepath.Join(layersDir, "icu-debug", "lib", "debug"))))
+		})
+
+		context("when BP_ICU_DEBUG_SYMBOLS cannot be parsed", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DEBUG_SYMBOLS", "not-a-bool")
+			})
+
+			it("returns an error", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring(`failed to parse BP_ICU_DEBUG_SYMBOLS value "not-a-bool"`)))
+			})
+		}, spec.Sequential())
+	}, spec.Sequential())
+
+	context("when optional layers from a previous build are no longer requested", func() {
+		it.Before(func() {
+			for _, name := range []string{"icu-static", "icu-debug"} {
+				Expect(os.MkdirAll(filepath.Join(layersDir, name, "lib"), os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, fmt.Sprintf("%s.toml", name)), nil, 0600)).To(Succeed())
+			}
+		})
+
+		it("removes those layers", func() {
+			_, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, name := range []string{"icu-static", "icu-debug"} {
+				Expect(filepath.Join(layersDir, name)).NotTo(BeADirectory())
+				Expect(filepath.Join(layersDir, fmt.Sprintf("%s.toml", name))).NotTo(BeAnExistingFile())
+			}
+		})
+	})
+
+	context("when buildpack.toml declares a version policy", func() {
+		it.Before(func() {
+			dependencyManager.ResolveCall.Returns.Dependency.Version = "74.1"
+
+			Expect(os.WriteFile(filepath.Join(cnbDir, "buildpack.toml"), []byte(`
+[metadata.icu-policy]
+  allow = [">= 72"]
+  deny = ["74.0"]
+
+  [[metadata.icu-policy.vulnerable-versions]]
+    versions = "< 74.2"
+    cves = ["CVE-2024-0001", "CVE-2024-0002"]
+`), 0600)).To(Succeed())
+		})
+
+		it("warns about the violations and installs ICU", func() {
+			var platformDirs []string
+			bindingResolver.ResolveCall.Stub = func(typ, provider, platformDir string) ([]servicebindings.Binding, error) {
+				if typ == "icu-policy" {
+					platformDirs = append(platformDirs, platformDir)
+				}
+
+				return nil, nil
+			}
+
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(1))
+
+			Expect(platformDirs).To(Equal([]string{"platform"}))
+
+			Expect(buffer.String()).To(ContainSubstring("WARNING: ICU 74.1 violates the version policy:"))
+			Expect(buffer.String()).To(ContainSubstring("version 74.1 is affected by CVE-2024-0001, CVE-2024-0002"))
+			Expect(buffer.String()).NotTo(ContainSubstring("is denied by"))
+			Expect(buffer.String()).NotTo(ContainSubstring("is not in the allowed ranges"))
+		})
+
+		context("when the version is denied and outside of the allowed ranges", func() {
+			it.Before(func() {
+				dependencyManager.ResolveCall.Returns.Dependency.Version = "74.0"
+				Expect(os.WriteFile(filepath.Join(cnbDir, "buildpack.toml"), []byte(`
+[metadata.icu-policy]
+  allow = [">= 75"]
+  deny = ["74.0"]
+`), 0600)).To(Succeed())
+			})
+
+			it("warns about each violation", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buffer.String()).To(ContainSubstring(`version 74.0 is denied by "74.0"`))
+				Expect(buffer.String()).To(ContainSubstring(`version 74.0 is not in the allowed ranges ">= 75"`))
+			})
+		})
+
+		context("when the dependency has passed its deprecation date", func() {
+			it.Before(func() {
+				dependencyManager.ResolveCall.Returns.Dependency.Version = "74.2"
+				dependencyManager.ResolveCall.Returns.Dependency.DeprecationDate = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+			})
+
+			it("warns about the deprecation", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buffer.String()).To(ContainSubstring("version 74.2 was deprecated on 2020-01-01"))
+			})
+		})
+
+		context("when BP_ICU_POLICY is fail", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_POLICY", "fail")
+			})
+
+			it("fails the build without installing ICU", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring("ICU 74.1 violates the version policy:\n  version 74.1 is affected by CVE-2024-0001, CVE-2024-0002")))
+
+				Expect(bindingResolver.ResolveCall.CallCount).To(Equal(0))
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+			})
+		}, spec.Sequential())
+
+		context("when an icu-policy binding sets the mode to fail", func() {
+			it.Before(func() {
+				bindingResolver.ResolveCall.Returns.BindingSlice = []servicebindings.Binding{
+					{
+						Name: "some-binding",
+						Type: "icu-policy",
+						Entries: map[string]*servicebindings.Entry{
+							"mode": servicebindings.NewWithValue([]byte("fail\n")),
+						},
+					},
+				}
+			})
+
+			it("fails the build", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring("ICU 74.1 violates the version policy")))
+			})
+
+			context("when BP_ICU_POLICY is warn", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_POLICY", "warn")
+				})
+
+				it("takes precedence over the binding", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.String()).To(ContainSubstring("WARNING: ICU 74.1 violates the version policy:"))
+				})
+			}, spec.Sequential())
+		})
+
+		context("when the dependency satisfies the policy", func() {
+			it.Before(func() {
+				dependencyManager.ResolveCall.Returns.Dependency.Version = "74.2"
+				t.Setenv("BP_ICU_POLICY", "fail")
+			})
+
+			it("installs ICU without warnings", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers).To(HaveLen(1))
+				Expect(buffer.String()).NotTo(ContainSubstring("version policy"))
+			})
+		}, spec.Sequential())
+
+		context("failure cases", func() {
+			context("when BP_ICU_POLICY is invalid", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_POLICY", "ignore")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(`invalid ICU policy mode "ignore": must be "warn" or "fail"`))
+				})
+			}, spec.Sequential())
+
+			context("when there is more than one icu-policy binding", func() {
+				it.Before(func() {
+					bindingResolver.ResolveCall.Returns.BindingSlice = []servicebindings.Binding{{Name: "first"}, {Name: "second"}}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to resolve icu-policy binding: expected at most 1 binding but found 2"))
+				})
+			})
+
+			context("when the bindings cannot be resolved", func() {
+				it.Before(func() {
+					bindingResolver.ResolveCall.Returns.Error = errors.New("failed to resolve bindings")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to resolve icu-policy binding: failed to resolve bindings"))
+				})
+			})
+
+			context("when the policy contains an invalid constraint", func() {
+				it.Before(func() {
+					Expect(os.WriteFile(filepath.Join(cnbDir, "buildpack.toml"), []byte(`
+[metadata.icu-policy]
+  deny = ["not-a-constraint"]
+`), 0600)).To(Succeed())
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(ContainSubstring(`failed to parse ICU version policy constraint "not-a-constraint"`)))
+				})
+			})
+
+			context("when buildpack.toml cannot be parsed", func() {
+				it.Before(func() {
+					Expect(os.WriteFile(filepath.Join(cnbDir, "buildpack.toml"), []byte("%%%"), 0600)).To(Succeed())
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(ContainSubstring("failed to parse ICU version policy")))
+				})
+			})
+		})
+	})
+
+	context("when BP_ICU_DEPENDENCY_URI is set", func() {
+		it.Before(func() {
+			t.Setenv("BP_ICU_DEPENDENCY_URI", "https://internal.example.com/icu/icu4c-74_2-custom.tgz")
+			t.Setenv("BP_ICU_DEPENDENCY_CHECKSUM", "some-custom-sha")
+		})
+
+		it("verifies and installs the custom dependency", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(1))
+			Expect(result.Layers[0].Metadata).To(Equal(map[string]interface{}{
+				"dependency-checksum": "sha256:some-custom-sha",
+				"installed-size":      int64(0),
+			}))
+
+			Expect(dependencyManager.ResolveCall.CallCount).To(Equal(0))
+
+			Expect(verifier.FetchCall.Receives.Dependency.URI).To(Equal("https://internal.example.com/icu/icu4c-74_2-custom.tgz"))
+			Expect(verifier.FetchCall.Receives.Dependency.Checksum).To(Equal("sha256:some-custom-sha"))
+			Expect(verifier.FetchCall.Receives.SignatureURI).To(BeEmpty())
+			Expect(verifier.FetchCall.Receives.Destination).NotTo(BeADirectory())
+
+			Expect(dependencyManager.DeliverCall.Receives.Dependency.URI).To(Equal(fmt.Sprintf("file://%s", filepath.Join(verifier.FetchCall.Receives.Destination, "icu.tgz"))))
+			Expect(dependencyManager.DeliverCall.Receives.Dependency.Version).To(Equal("74.2"))
+			Expect(dependencyManager.DeliverCall.Receives.CnbPath).To(Equal("/"))
+			Expect(dependencyManager.DeliverCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, ".icu.staging")))
+
+			Expect(sbomGenerator.GenerateFromDependencyCall.Receives.Dependency.URI).To(Equal("https://internal.example.com/icu/icu4c-74_2-custom.tgz"))
+			Expect(sbomGenerator.GenerateFromDependencyCall.Receives.Dependency.PURL).To(Equal("pkg:generic/icu@74.2?checksum=some-custom-sha&download_url=https://internal.example.com/icu/icu4c-74_2-custom.tgz"))
+			Expect(dependencyManager.GenerateBillOfMaterialsCall.Receives.Dependencies[0].URI).To(Equal("https://internal.example.com/icu/icu4c-74_2-custom.tgz"))
+
+			Expect(buffer.String()).To(ContainSubstring("Selected ICU version (using BP_ICU_DEPENDENCY_URI): 74.2"))
+			Expect(buffer.String()).To(ContainSubstring("Verified checksum sha256:some-custom-sha"))
+		})
+
+		context("when BP_ICU_DEPENDENCY_VERSION is set", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DEPENDENCY_URI", "file:///mnt/artifacts/internal.tgz")
+				t.Setenv("BP_ICU_DEPENDENCY_VERSION", "78.3")
+			})
+
+			it("uses that version", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(dependencyManager.DeliverCall.Receives.Dependency.Version).To(Equal("78.3"))
+			})
+		}, spec.Sequential())
+
+		context("when a signature URI and keyring binding are provided", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DEPENDENCY_SIGNATURE_URI", "https://internal.example.com/icu/icu4c-74_2-custom.tgz.asc")
+
+				bindingResolver.ResolveCall.Stub = func(typ, provider, platformDir string) ([]servicebindings.Binding, error) {
+					if typ != "icu-keyring" {
+						return nil, nil
+					}
+
+					return []servicebindings.Binding{
+						{
+							Name: "some-keyring",
+							Type: "icu-keyring",
+							Entries: map[string]*servicebindings.Entry{
+								"b.asc": servicebindings.NewWithValue([]byte("second-key")),
+								"a.asc": servicebindings.NewWithValue([]byte("first-key")),
+							},
+						},
+					}, nil
+				}
+			})
+
+			it("verifies the signature against the bound keyring", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(verifier.FetchCall.Receives.SignatureURI).To(Equal("https://internal.example.com/icu/icu4c-74_2-custom.tgz.asc"))
+				Expect(string(verifier.FetchCall.Receives.Keyring)).To(Equal("first-key\nsecond-key\n"))
+
+				Expect(buffer.String()).To(ContainSubstring("Verified OpenPGP signature https://internal.example.com/icu/icu4c-74_2-custom.tgz.asc"))
+			})
+		}, spec.Sequential())
+
+		context("when there is a cache match in the layer metadata", func() {
+			it.Before(func() {
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"sha256:some-custom-sha\"\n"), 0600)).To(Succeed())
+			})
+
+			it("reuses the layer without fetching the dependency", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(verifier.FetchCall.CallCount).To(Equal(0))
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+			})
+		})
+
+		context("failure cases", func() {
+			context("when BP_ICU_DEPENDENCY_CHECKSUM is not set", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_DEPENDENCY_CHECKSUM", "")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("BP_ICU_DEPENDENCY_CHECKSUM must be set when BP_ICU_DEPENDENCY_URI is set"))
+				})
+			}, spec.Sequential())
+
+			context("when the URI scheme is not supported", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_DEPENDENCY_URI", "http://internal.example.com/icu/icu4c-74_2-custom.tgz")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(ContainSubstring("scheme must be file or https")))
+				})
+			}, spec.Sequential())
+
+			context("when the version cannot be inferred", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_DEPENDENCY_URI", "https://internal.example.com/artifact.tgz")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to determine the ICU version of https://internal.example.com/artifact.tgz: set BP_ICU_DEPENDENCY_VERSION"))
+				})
+			}, spec.Sequential())
+
+			context("when the dependency fails verification", func() {
+				it.Before(func() {
+					verifier.FetchCall.Stub = nil
+					verifier.FetchCall.Returns.Error = errors.New("failed to verify")
+				})
+
+				it("does not deliver the dependency", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to verify"))
+
+					Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+				})
+			})
+
+			context("when the keyring binding cannot be resolved", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_DEPENDENCY_SIGNATURE_URI", "https://internal.example.com/icu/icu4c-74_2-custom.tgz.asc")
+					bindingResolver.ResolveCall.Stub = func(typ, provider, platformDir string) ([]servicebindings.Binding, error) {
+						if typ == "icu-keyring" {
+							return nil, errors.New("failed to resolve bindings")
+						}
+
+						return nil, nil
+					}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to resolve icu-keyring binding: failed to resolve bindings"))
+				})
+			}, spec.Sequential())
+
+			context("when the plan entry requests static libraries", func() {
+				it.Before(func() {
+					buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+						"build":  true,
+						"static": true,
+					}
+				})
+
+				it("returns an error without installing anything", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to install ICU: static libraries and debug symbols are not available for a custom dependency set with BP_ICU_DEPENDENCY_URI"))
+
+					Expect(dependencyManager.ResolveCall.CallCount).To(Equal(0))
+					Expect(verifier.FetchCall.CallCount).To(Equal(0))
+				})
+			})
+
+			context("when BP_ICU_DEBUG_SYMBOLS is set", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_DEBUG_SYMBOLS", "true")
+				})
+
+				it("returns an error without installing anything", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to install ICU: static libraries and debug symbols are not available for a custom dependency set with BP_ICU_DEPENDENCY_URI"))
+
+					Expect(dependencyManager.ResolveCall.CallCount).To(Equal(0))
+					Expect(verifier.FetchCall.CallCount).To(Equal(0))
+				})
+			}, spec.Sequential())
+		})
+	}, spec.Sequential())
+
+	context("when icu bindings provide data overrides", func() {
+		it.Before(func() {
+			dependencyManager.ResolveCall.Returns.Dependency.Version = "78.3"
+
+			buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+				"launch": true,
+			}
+
+			bindingResolver.ResolveCall.Stub = func(typ, provider, platformDir string) ([]servicebindings.Binding, error) {
+				if typ != "icu" {
+					return nil, nil
+				}
+
+				return []servicebindings.Binding{
+					{
+						Name: "second-binding",
+						Type: "icu",
+						Entries: map[string]*servicebindings.Entry{
+							"translit-Custom.res": servicebindings.NewWithValue([]byte("translit-content")),
+							"filters.json":        servicebindings.NewWithValue([]byte(`{"localeFilter": {}}`)),
+							"README":              servicebindings.NewWithValue([]byte("ignored")),
+						},
+					},
+					{
+						Name: "first-binding",
+						Type: "icu",
+						Entries: map[string]*servicebindings.Entry{
+							"icudt78l.dat": servicebindings.NewWithValue([]byte("data-content")),
+							"coll-de.res":  servicebindings.NewWithValue([]byte("coll-content")),
+							"root.res":     servicebindings.NewWithValue([]byte("root-content")),
+						},
+					},
+				}, nil
+			}
+		})
+
+		it("installs the overrides into a layer and sets ICU_DATA", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(2))
+			layer := result.Layers[1]
+
+			Expect(layer.Name).To(Equal("icu-overrides"))
+			Expect(layer.Launch).To(BeTrue())
+			Expect(layer.Build).To(BeFalse())
+			Expect(layer.Cache).To(BeFalse())
+			Expect(layer.Metadata).To(HaveKeyWithValue("binding-digest", MatchRegexp(`^[0-9a-f]{64}$`)))
+
+			dataDir := filepath.Join(layersDir, "icu-overrides", "data")
+			for path, content := range map[string]string{
+				"icudt78l.dat":                           "data-content",
+				"icudt78l/coll/de.res":                   "coll-content",
+				"icudt78l/root.res":                      "root-content",
+				"icudt78l/translit/Custom.res":           "translit-content",
+				filepath.Join("filters", "filters.json"): `{"localeFilter": {}}`,
+			} {
+				Expect(os.ReadFile(filepath.Join(dataDir, path))).To(Equal([]byte(content)), path)
+			}
+			Expect(filepath.Join(dataDir, "README")).NotTo(BeAnExistingFile())
+
+			Expect(layer.SharedEnv).To(Equal(packit.Environment{
+				"ICU_DATA.override": fmt.Sprintf("%s:%s", dataDir, filepath.Join(layersDir, "icu", "share", "icu", "78.3")),
+			}))
+			Expect(layer.BuildEnv).To(Equal(packit.Environment{
+				"ICU_DATA_FILTER_FILE.override": filepath.Join(dataDir, "filters", "filters.json"),
+			}))
+
+			Expect(buffer.String()).To(ContainSubstring("Installing ICU data overrides"))
+			Expect(buffer.String()).To(ContainSubstring("Adding icudt78l/coll/de.res from binding first-binding"))
+		})
+
+		context("when a data file does not match the ICU major version", func() {
+			it.Before(func() {
+				dependencyManager.ResolveCall.Returns.Dependency.Version = "77.1"
+			})
+
+			it("warns", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buffer.String()).To(ContainSubstring("WARNING: icudt78l.dat does not match ICU 77.1 and will be ignored by ICU"))
+			})
+		})
+
+		context("when the binding content is unchanged", func() {
+			it("reuses the layer", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-overrides.toml"),
+					[]byte(fmt.Sprintf("[metadata]\nbinding-digest = %q\n", result.Layers[1].Metadata["binding-digest"])), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-overrides", "data", "sentinel"), nil, 0600)).To(Succeed())
+
+				buffer.Reset()
+				result, err = build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers[1].Name).To(Equal("icu-overrides"))
+				Expect(filepath.Join(layersDir, "icu-overrides", "data", "sentinel")).To(BeAnExistingFile())
+				Expect(buffer.String()).To(ContainSubstring(fmt.Sprintf("Reusing cached layer %s", filepath.Join(layersDir, "icu-overrides"))))
+			})
+		})
+
+		context("when the binding content changes", func() {
+			it.Before(func() {
+				Expect(os.MkdirAll(filepath.Join(layersDir, "icu-overrides", "data"), os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-overrides", "data", "sentinel"), nil, 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-overrides.toml"),
+					[]byte("[metadata]\nbinding-digest = \"some-other-digest\"\n"), 0600)).To(Succeed())
+			})
+
+			it("rebuilds the layer", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(filepath.Join(layersDir, "icu-overrides", "data", "sentinel")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(layersDir, "icu-overrides", "data", "icudt78l.dat")).To(BeARegularFile())
+			})
+		})
+
+		context("when no icu binding is present", func() {
+			it.Before(func() {
+				bindingResolver.ResolveCall.Stub = nil
+
+				Expect(os.MkdirAll(filepath.Join(layersDir, "icu-overrides", "data"), os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-overrides.toml"), nil, 0600)).To(Succeed())
+			})
+
+			it("removes the overrides layer", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers).To(HaveLen(1))
+				Expect(filepath.Join(layersDir, "icu-overrides")).NotTo(BeADirectory())
+				Expect(filepath.Join(layersDir, "icu-overrides.toml")).NotTo(BeAnExistingFile())
+			})
+		})
+
+		context("failure cases", func() {
+			context("when the bindings cannot be resolved", func() {
+				it.Before(func() {
+					bindingResolver.ResolveCall.Stub = nil
+					bindingResolver.ResolveCall.Returns.Error = errors.New("failed to resolve bindings")
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError(ContainSubstring("failed to resolve bindings")))
+				})
+			})
+
+			context("when more than one data filter is provided", func() {
+				it.Before(func() {
+					bindingResolver.ResolveCall.Stub = func(typ, provider, platformDir string) ([]servicebindings.Binding, error) {
+						return []servicebindings.Binding{
+							{
+								Name: "some-binding",
+								Entries: map[string]*servicebindings.Entry{
+									"a.json": servicebindings.NewWithValue([]byte("{}")),
+									"b.json": servicebindings.NewWithValue([]byte("{}")),
+								},
+							},
+						}, nil
+					}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to resolve icu binding: expected at most 1 data filter but found 2"))
+				})
+			})
+		})
+	})
+
+	context("when delivered ICU trees are cached", func() {
+		it.Before(func() {
+			t.Setenv("BP_ICU_CACHE_SIZE", "2")
+
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				return os.WriteFile(filepath.Join(layerPath, "delivered"), []byte(dependency.Checksum), 0600)
+			}
+		})
+
+		it("stores the delivered tree in a cache-only layer", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(2))
+			layer := result.Layers[1]
+
+			Expect(layer.Name).To(Equal("icu-cache"))
+			Expect(layer.Launch).To(BeFalse())
+			Expect(layer.Build).To(BeFalse())
+			Expect(layer.Cache).To(BeTrue())
+			entries := layer.Metadata["entries"].(map[string]interface{})
+			Expect(entries).To(HaveLen(1))
+			Expect(entries["icu-dependency-sha"]).To(HaveKeyWithValue("checksum", "icu-dependency-sha"))
+			Expect(entries["icu-dependency-sha"]).To(HaveKeyWithValue("version", "icu-dependency-version"))
+			Expect(entries["icu-dependency-sha"]).To(HaveKeyWithValue("last-used", MatchRegexp(`^\d{4}-\d{2}-\d{2}T`)))
+
+			Expect(os.ReadFile(filepath.Join(layersDir, "icu-cache", "icu-dependency-sha", "delivered"))).To(Equal([]byte("icu-dependency-sha")))
+		})
+
+		context("when the cache holds the resolved dependency", func() {
+			it.Before(func() {
+				Expect(os.MkdirAll(filepath.Join(layersDir, "icu-cache", "sha256-other-sha", "lib"), os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-cache", "sha256-other-sha", "lib", "libicuuc.so"), []byte("cached"), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-cache.toml"), []byte(`[metadata.entries.sha256-other-sha]
+checksum = "sha256:other-sha"
+version = "76.1"
+last-used = "2020-01-01T00:00:00Z"
+`), 0600)).To(Succeed())
+
+				dependencyManager.ResolveCall.Returns.Dependency.Checksum = "sha256:other-sha"
+				dependencyManager.ResolveCall.Returns.Dependency.Version = "76.1"
+			})
+
+			it("restores the layer from the cache without delivering", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so"))).To(Equal([]byte("cached")))
+
+				Expect(result.Layers[0].Metadata).To(Equal(map[string]interface{}{
+					"dependency-checksum": "sha256:other-sha",
+					"installed-size":      int64(6),
+				}))
+
+				entries := result.Layers[1].Metadata["entries"].(map[string]interface{})
+				Expect(entries["sha256-other-sha"]).To(HaveKeyWithValue("last-used", Not(Equal("2020-01-01T00:00:00Z"))))
+
+				Expect(buffer.String()).To(ContainSubstring(fmt.Sprintf("Restored ICU 76.1 from %s", filepath.Join(layersDir, "icu-cache"))))
+			})
+
+			context("when the cached tree is missing", func() {
+				it.Before(func() {
+					Expect(os.RemoveAll(filepath.Join(layersDir, "icu-cache", "sha256-other-sha"))).To(Succeed())
+				})
+
+				it("delivers the dependency", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+				})
+			})
+
+			context("when the cache is full", func() {
+				it.Before(func() {
+					t.Setenv("BP_ICU_CACHE_SIZE", "1")
+
+					dependencyManager.ResolveCall.Returns.Dependency.Checksum = "sha256:new-sha"
+					dependencyManager.ResolveCall.Returns.Dependency.Version = "78.3"
+				})
+
+				it("evicts the least recently used tree", func() {
+					result, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(filepath.Join(layersDir, "icu-cache", "sha256-other-sha")).NotTo(BeADirectory())
+					Expect(filepath.Join(layersDir, "icu-cache", "sha256-new-sha")).To(BeADirectory())
+
+					entries := result.Layers[1].Metadata["entries"].(map[string]interface{})
+					Expect(entries).To(HaveLen(1))
+					Expect(entries).To(HaveKey("sha256-new-sha"))
+				})
+			}, spec.Sequential())
+		})
+
+		context("when the previously installed version is cached", func() {
+			it.Before(func() {
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"sha256:old-sha\"\n"), 0600)).To(Succeed())
+
+				Expect(os.MkdirAll(filepath.Join(layersDir, "icu-cache", "sha256-old-sha"), os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-cache", "sha256-old-sha.manifest.json"),
+					[]byte(`{"files": [{"path": "lib/libicuuc.so.78.2", "mode": "0755", "sha256": "old-file-sha"}]}`), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-cache.toml"), []byte(`[metadata.entries.sha256-old-sha]
+checksum = "sha256:old-sha"
+version = "78.2"
+last-used = "2020-01-01T00:00:00Z"
+`), 0600)).To(Succeed())
+
+				dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+					if id == "icu-manifest" {
+						return postal.Dependency{
+							ID:       id,
+							Checksum: "icu-manifest-sha",
+							URI:      "https://example.com/icu/icu-manifest_78.3.json",
+							Version:  version,
+						}, nil
+					}
+
+					return postal.Dependency{
+						ID:       id,
+						Checksum: "sha256:new-sha",
+						URI:      "icu-dependency-uri",
+						Version:  "78.3",
+					}, nil
+				}
+
+				dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+					if dependency.ID == "icu-manifest" {
+						return os.WriteFile(filepath.Join(layerPath, dependency.Name),
+							[]byte(`{"version": "78.3", "objects": "objects/", "files": [{"path": "lib/libicuuc.so.78.3", "mode": "0755", "sha256": "new-file-sha"}]}`), 0600)
+					}
+
+					return os.WriteFile(filepath.Join(layerPath, "delivered"), []byte(dependency.Checksum), 0600)
+				}
+
+				layerPatcher.PatchCall.Returns.Int = 1
+			})
+
+			it("patches the layer from the cached tree", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(layerPatcher.PatchCall.CallCount).To(Equal(1))
+				Expect(layerPatcher.PatchCall.Receives.Previous).To(Equal(icu.Manifest{
+					Files: []icu.ManifestFile{{Path: "lib/libicuuc.so.78.2", Mode: "0755", SHA256: "old-file-sha"}},
+				}))
+				Expect(layerPatcher.PatchCall.Receives.Next).To(Equal(icu.Manifest{
+					Version: "78.3",
+					Objects: "https://example.com/icu/objects/",
+					Files:   []icu.ManifestFile{{Path: "lib/libicuuc.so.78.3", Mode: "0755", SHA256: "new-file-sha"}},
+				}))
+				Expect(layerPatcher.PatchCall.Receives.PreviousPath).To(Equal(filepath.Join(layersDir, "icu-cache", "sha256-old-sha")))
+				Expect(layerPatcher.PatchCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, ".icu.staging")))
+
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+				Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu-manifest"))
+
+				Expect(filepath.Join(layersDir, "icu-cache", "sha256-new-sha.manifest.json")).To(BeARegularFile())
+
+				Expect(buffer.String()).To(ContainSubstring("Upgraded incrementally from the cached tree, fetched 1 of 1 files"))
+			})
+
+			context("when the patch fails", func() {
+				it.Before(func() {
+					layerPatcher.PatchCall.Stub = func(previous, next icu.Manifest, previousPath, layerPath, platformPath string) (int, error) {
+						Expect(os.WriteFile(filepath.Join(layerPath, "partial"), nil, 0600)).To(Succeed())
+						return 0, errors.New("sha256 mismatch")
+					}
+				})
+
+				it("falls back to delivering the dependency in full", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(dependencyManager.DeliverCall.CallCount).To(Equal(2))
+					Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu"))
+
+					Expect(filepath.Join(layersDir, "icu", "partial")).NotTo(BeAnExistingFile())
+					Expect(filepath.Join(layersDir, "icu", "delivered")).To(BeARegularFile())
+
+					Expect(buffer.String()).To(ContainSubstring("Incremental upgrade failed, falling back to a full installation: sha256 mismatch"))
+				})
+			})
+
+			context("when the manifest cannot be parsed", func() {
+				it.Before(func() {
+					dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+						if dependency.ID == "icu-manifest" {
+							return os.WriteFile(filepath.Join(layerPath, dependency.Name), []byte("%%%"), 0600)
+						}
+
+						return nil
+					}
+				})
+
+				it("delivers the dependency in full", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(layerPatcher.PatchCall.CallCount).To(Equal(0))
+					Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu"))
+
+					Expect(buffer.String()).To(ContainSubstring("Incremental upgrade is not possible: failed to parse ICU manifest"))
+				})
+			})
+
+			context("when no manifest is published for the version", func() {
+				it.Before(func() {
+					stub := dependencyManager.ResolveCall.Stub
+					dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+						if id == "icu-manifest" {
+							return postal.Dependency{}, &postal.ErrNoDeps{}
+						}
+
+						return stub(path, id, version, stack)
+					}
+				})
+
+				it("delivers the dependency in full", func() {
+					_, err := build(buildContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(layerPatcher.PatchCall.CallCount).To(Equal(0))
+					Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+					Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu"))
+				})
+			})
+
+			context("when the manifest cannot be resolved", func() {
+				it.Before(func() {
+					stub := dependencyManager.ResolveCall.Stub
+					dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+						if id == "icu-manifest" {
+							return postal.Dependency{}, errors.New("failed to parse buildpack.toml")
+						}
+
+						return stub(path, id, version, stack)
+					}
+				})
+
+				it("returns an error", func() {
+					_, err := build(buildContext)
+					Expect(err).To(MatchError("failed to resolve ICU manifest: failed to parse buildpack.toml"))
+
+					Expect(layerPatcher.PatchCall.CallCount).To(Equal(0))
+					Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		context("when BP_ICU_CACHE_SIZE is 0", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_CACHE_SIZE", "0")
+
+				Expect(os.MkdirAll(filepath.Join(layersDir, "icu-cache", "sha256-other-sha"), os.ModePerm)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu-cache.toml"), nil, 0600)).To(Succeed())
+			})
+
+			it("removes the cache layer", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers).To(HaveLen(1))
+				Expect(filepath.Join(layersDir, "icu-cache")).NotTo(BeADirectory())
+				Expect(filepath.Join(layersDir, "icu-cache.toml")).NotTo(BeAnExistingFile())
+			})
+		}, spec.Sequential())
+
+		context("when BP_ICU_CACHE_SIZE cannot be parsed", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_CACHE_SIZE", "many")
+			})
+
+			it("returns an error", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring(`failed to parse BP_ICU_CACHE_SIZE value "many"`)))
+			})
+		}, spec.Sequential())
+
+		context("when BP_ICU_CACHE_SIZE is negative", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_CACHE_SIZE", "-1")
+			})
+
+			it("returns an error", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(`failed to parse BP_ICU_CACHE_SIZE value "-1": must not be negative`))
+			})
+		}, spec.Sequential())
+	}, spec.Sequential())
+
+	context("when the previous layer is upgraded without the dependency cache", func() {
+		it.Before(func() {
+			Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+				[]byte("[metadata]\ndependency-checksum = \"sha256:old-sha\"\n"), 0600)).To(Succeed())
+
+			Expect(os.MkdirAll(filepath.Join(layersDir, "icu", "lib"), os.ModePerm)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.78.2"), []byte("old-library"), 0644)).To(Succeed())
+
+			dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+				if id == "icu-manifest" {
+					return postal.Dependency{
+						ID:       id,
+						Checksum: "icu-manifest-sha",
+						URI:      "https://example.com/icu/icu-manifest_78.3.json",
+						Version:  version,
+					}, nil
+				}
+
+				return postal.Dependency{
+					ID:       id,
+					Checksum: "sha256:new-sha",
+					URI:      "icu-dependency-uri",
+					Version:  "78.3",
+				}, nil
+			}
+
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				if dependency.ID == "icu-manifest" {
+					return os.WriteFile(filepath.Join(layerPath, dependency.Name),
+						[]byte(`{"version": "78.3", "objects": "objects/", "files": [{"path": "lib/libicuuc.so.78.3", "mode": "0755", "sha256": "new-file-sha"}]}`), 0600)
+				}
+
+				return os.WriteFile(filepath.Join(layerPath, "delivered"), []byte(dependency.Checksum), 0600)
+			}
+
+			layerPatcher.PatchCall.Returns.Int = 1
+		})
+
+		it("patches the layer from the previous layer", func() {
+			_, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			sum := sha256.Sum256([]byte("old-library"))
+
+			Expect(layerPatcher.PatchCall.CallCount).To(Equal(1))
+			Expect(layerPatcher.PatchCall.Receives.Previous).To(Equal(icu.Manifest{
+				Files: []icu.ManifestFile{{Path: "lib/libicuuc.so.78.2", Mode: "0644", SHA256: hex.EncodeToString(sum[:])}},
+			}))
+			Expect(layerPatcher.PatchCall.Receives.PreviousPath).To(Equal(filepath.Join(layersDir, "icu")))
+			Expect(layerPatcher.PatchCall.Receives.LayerPath).To(Equal(filepath.Join(layersDir, ".icu.staging")))
+			Expect(layerPatcher.PatchCall.Receives.PlatformPath).To(Equal("platform"))
+
+			Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+			Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu-manifest"))
+
+			Expect(filepath.Join(layersDir, "icu-cache")).NotTo(BeAnExistingFile())
+
+			Expect(buffer.String()).To(ContainSubstring("Upgraded incrementally from the previous layer, fetched 1 of 1 files"))
+		})
+
+		context("when the files of the previous layer were not restored", func() {
+			it.Before(func() {
+				Expect(os.RemoveAll(filepath.Join(layersDir, "icu"))).To(Succeed())
+			})
+
+			it("delivers the dependency in full", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(layerPatcher.PatchCall.CallCount).To(Equal(0))
+				Expect(dependencyManager.DeliverCall.CallCount).To(Equal(1))
+				Expect(dependencyManager.DeliverCall.Receives.Dependency.ID).To(Equal("icu"))
+			})
+		})
+	})
+
+	context("when BP_ICU_DISABLE is set", func() {
+		it.Before(func() {
+			t.Setenv("BP_ICU_DISABLE", "true")
+
+			Expect(os.MkdirAll(filepath.Join(layersDir, "icu", "lib"), os.ModePerm)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+				[]byte("[metadata]\ndependency-checksum = \"icu-dependency-sha\"\n"), 0600)).To(Succeed())
+
+			buildContext.Plan.Entries = []packit.BuildpackPlanEntry{
+				{
+					Name: "icu",
+					Metadata: map[string]interface{}{
+						"launch":         true,
+						"version":        "70.*",
+						"version-source": "dotnet-31",
+					},
+				},
+				{
+					Name: "icu",
+				},
+			}
+		})
+
+		it("contributes no layer, removes the cached layer and warns about the overridden entries", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).To(Equal(packit.BuildResult{}))
+
+			Expect(filepath.Join(layersDir, "icu")).NotTo(BeADirectory())
+			Expect(filepath.Join(layersDir, "icu.toml")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(layersDir, "icu-static")).NotTo(BeADirectory())
+
+			Expect(dependencyManager.ResolveCall.CallCount).To(Equal(0))
+			Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+
+			Expect(buffer.String()).To(ContainSubstring("WARNING: ICU installation is disabled by BP_ICU_DISABLE=true"))
+			Expect(buffer.String()).To(ContainSubstring("The following requirements for ICU will not be satisfied:"))
+			Expect(buffer.String()).To(ContainSubstring(`dotnet-31 -> "70.*" (launch)`))
+			Expect(buffer.String()).To(ContainSubstring(`<unknown> -> "*"`))
+		})
+
+		context("when BP_ICU_DISABLE is false", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DISABLE", "false")
+			})
+
+			it("installs ICU", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers).To(HaveLen(1))
+				Expect(dependencyManager.ResolveCall.CallCount).To(Equal(1))
+			})
+		}, spec.Sequential())
+
+		context("when BP_ICU_DISABLE cannot be parsed", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DISABLE", "not-a-bool")
+			})
+
+			it("returns an error", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring(`failed to parse BP_ICU_DISABLE value "not-a-bool"`)))
+			})
+		}, spec.Sequential())
+	}, spec.Sequential())
+
+	context("when DOTNET_SYSTEM_GLOBALIZATION_INVARIANT is enabled", func() {
+		it.Before(func() {
+			t.Setenv("DOTNET_SYSTEM_GLOBALIZATION_INVARIANT", "1")
+		})
+
+		it("contributes no layer and warns", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(BeEmpty())
+			Expect(dependencyManager.DeliverCall.CallCount).To(Equal(0))
+
+			Expect(buffer.String()).To(ContainSubstring("WARNING: ICU installation is disabled by DOTNET_SYSTEM_GLOBALIZATION_INVARIANT=1"))
+		})
+	}, spec.Sequential())
+
+	context("when a previous build installed a different version", func() {
+		it.Before(func() {
+			Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+				[]byte("[metadata]\ndependency-checksum = \"previous-dependency-sha\"\n"), 0600)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(layersDir, "icu", "lib"), os.ModePerm)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.77"), []byte("previous"), 0644)).To(Succeed())
+
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				err := os.MkdirAll(filepath.Join(layerPath, "lib"), os.ModePerm)
+				if err != nil {
+					return err
+				}
+
+				return os.WriteFile(filepath.Join(layerPath, "lib", "libicuuc.so.78"), []byte("next"), 0644)
+			}
+		})
+
+		it("replaces the layer contents once the build has succeeded", func() {
+			_, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.ReadFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.78"))).To(Equal([]byte("next")))
+			Expect(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.77")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(layersDir, ".icu.staging")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(layersDir, ".icu.previous")).NotTo(BeAnExistingFile())
+		})
+
+		context("when the delivery fails", func() {
+			it.Before(func() {
+				deliver := dependencyManager.DeliverCall.Stub
+				dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+					err := deliver(dependency, cnbPath, layerPath, platformPath)
+					if err != nil {
+						return err
+					}
+
+					return errors.New("failed to install dependency")
+				}
+			})
+
+			it("leaves the previous layer contents in place", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError("failed to install dependency"))
+
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.77"))).To(Equal([]byte("previous")))
+				Expect(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.78")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(layersDir, ".icu.staging")).NotTo(BeAnExistingFile())
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu.toml"))).To(ContainSubstring("previous-dependency-sha"))
+			})
+		})
+
+		context("when generating the SBOM fails", func() {
+			it.Before(func() {
+				sbomGenerator.GenerateFromDependencyCall.Returns.Error = errors.New("failed to generate SBOM")
+			})
+
+			it("restores the previous layer contents", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError("failed to generate SBOM"))
+
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.77"))).To(Equal([]byte("previous")))
+				Expect(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.78")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(layersDir, ".icu.staging")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(layersDir, ".icu.previous")).NotTo(BeAnExistingFile())
+			})
+		})
+
+		context("when the dependency does not include the Node.js ICU data", func() {
+			it.Before(func() {
+				buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+					"launch":    true,
+					"node-data": true,
+				}
+			})
+
+			it("restores the previous layer contents", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring("failed to configure Node.js ICU data")))
+
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.77"))).To(Equal([]byte("previous")))
+				Expect(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.78")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(layersDir, ".icu.previous")).NotTo(BeAnExistingFile())
+			})
+		})
+
+		context("when installing a companion layer fails", func() {
+			it.Before(func() {
+				dependencyManager.ResolveCall.Stub = func(path, id, version, stack string) (postal.Dependency, error) {
+					if id == "icu-static" {
+						return postal.Dependency{}, errors.New("failed to resolve static libraries")
+					}
+
+					return postal.Dependency{ID: "icu", Checksum: "icu-dependency-sha", Version: "78.3"}, nil
+				}
+
+				buildContext.Plan.Entries[0].Metadata = map[string]interface{}{
+					"build":  true,
+					"static": true,
+				}
+			})
+
+			it("restores the previous ICU layer contents", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError("failed to resolve static libraries"))
+
+				Expect(os.ReadFile(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.77"))).To(Equal([]byte("previous")))
+				Expect(filepath.Join(layersDir, "icu", "lib", "libicuuc.so.78")).NotTo(BeAnExistingFile())
+				Expect(filepath.Join(layersDir, ".icu.previous")).NotTo(BeAnExistingFile())
+			})
+		})
+	})
+
+	context("when a build report is written", func() {
+		var report map[string]interface{}
+
+		readReport := func(path string) {
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			report = nil
+			Expect(json.Unmarshal(content, &report)).To(Succeed())
+		}
+
+		it.Before(func() {
+			buildContext.Plan.Entries = []packit.BuildpackPlanEntry{
+				{
+					Name: "icu",
+					Metadata: map[string]interface{}{
+						"launch":         true,
+						"version":        "78.*",
+						"version-source": "dotnet-10",
+					},
+				},
+				{
+					Name: "icu",
+					Metadata: map[string]interface{}{
+						"version":        "77.*",
+						"version-source": "BP_ICU_VERSION",
+					},
+				},
+			}
+
+			dependencyManager.ResolveCall.Returns.Dependency.CPE = "icu-cpe"
+			dependencyManager.ResolveCall.Returns.Dependency.PURL = "icu-purl"
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				return os.WriteFile(filepath.Join(layerPath, "libicuuc.so"), []byte("library"), 0644)
+			}
+
+			t.Setenv("BP_ICU_REPORT_PATH", filepath.Join("reports", "icu.json"))
+		})
+
+		it("writes the report to that path", func() {
+			result, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Layers).To(HaveLen(1))
+
+			readReport(filepath.Join(workingDir, "reports", "icu.json"))
+			Expect(report).To(HaveKeyWithValue("candidates", []interface{}{
+				map[string]interface{}{"version-source": "dotnet-10", "version": "78.*", "priority": 1.0},
+				map[string]interface{}{"version-source": "BP_ICU_VERSION", "version": "77.*", "priority": 2.0},
+			}))
+			Expect(report).To(HaveKeyWithValue("dependency", map[string]interface{}{
+				"id":       "icu",
+				"name":     "ICU",
+				"version":  "icu-dependency-version",
+				"uri":      "icu-dependency-uri",
+				"checksum": "icu-dependency-sha",
+				"cpe":      "icu-cpe",
+				"purl":     "icu-purl",
+				"custom":   false,
+			}))
+			Expect(report).To(HaveKeyWithValue("layer", map[string]interface{}{
+				"name":           "icu",
+				"reused":         false,
+				"reason":         "there is no layer from a previous build",
+				"installed-from": "download",
+				"installed-size": 7.0,
+			}))
+			Expect(report).To(HaveKeyWithValue("durations", HaveKeyWithValue("resolve", BeNumerically(">=", 0))))
+			Expect(report).To(HaveKeyWithValue("durations", HaveKeyWithValue("deliver", BeNumerically(">=", 0))))
+			Expect(report).To(HaveKeyWithValue("durations", HaveKeyWithValue("sbom", BeNumerically(">=", 0))))
+
+			Expect(result.Layers[0].Metadata).To(HaveKeyWithValue("installed-size", int64(7)))
+			Expect(buffer.String()).To(ContainSubstring(fmt.Sprintf("Wrote build report to %s", filepath.Join(workingDir, "reports", "icu.json"))))
+		})
+
+		context("when the layer is reused", func() {
+			it.Before(func() {
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"icu-dependency-sha\"\ninstalled-size = 1234\n"), 0600)).To(Succeed())
+			})
+
+			it("reports why", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				readReport(filepath.Join(workingDir, "reports", "icu.json"))
+				Expect(report).To(HaveKeyWithValue("layer", map[string]interface{}{
+					"name":           "icu",
+					"reused":         true,
+					"reason":         "the dependency checksum matches the layer from the previous build",
+					"installed-size": 1234.0,
+				}))
+				Expect(report).To(HaveKeyWithValue("durations", HaveKeyWithValue("deliver", 0.0)))
+				Expect(report).To(HaveKeyWithValue("durations", HaveKeyWithValue("sbom", 0.0)))
+			})
+		})
+
+		context("when the dependency checksum changed", func() {
+			it.Before(func() {
+				Expect(os.WriteFile(filepath.Join(layersDir, "icu.toml"),
+					[]byte("[metadata]\ndependency-checksum = \"previous-dependency-sha\"\n"), 0600)).To(Succeed())
+			})
+
+			it("reports why the layer was rebuilt", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				readReport(filepath.Join(workingDir, "reports", "icu.json"))
+				Expect(report).To(HaveKeyWithValue("layer", HaveKeyWithValue("reason", "the dependency checksum differs from the layer from the previous build")))
+			})
+		})
+
+		context("when BP_ICU_REPORT_PATH is absolute", func() {
+			var reportsDir string
+
+			it.Before(func() {
+				reportsDir = t.TempDir()
+				t.Setenv("BP_ICU_REPORT_PATH", filepath.Join(reportsDir, "icu.json"))
+			})
+
+			it("writes the report to that path", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				readReport(filepath.Join(reportsDir, "icu.json"))
+				Expect(report).To(HaveKeyWithValue("dependency", HaveKeyWithValue("checksum", "icu-dependency-sha")))
+			})
+		})
+
+		context("when BP_ICU_REPORT_PATH is not set", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_REPORT_PATH", "")
+			})
+
+			it("writes no report", func() {
+				result, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result.Layers).To(HaveLen(1))
+				Expect(filepath.Join(workingDir, "reports")).NotTo(BeAnExistingFile())
+
+				Expect(buffer.String()).NotTo(ContainSubstring("Wrote build report"))
+			})
+		})
+	}, spec.Sequential())
+
+	context("when the layer contents are written at different times", func() {
+		var (
+			otherLayersDir string
+			deliveries     int
+		)
+
+		// tree describes the mode, modification time and content of every entry
+		// below the given directory
+		tree := func(root string) map[string]string {
+			entries := map[string]string{}
+			err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+
+				rel, err := filepath.Rel(root, path)
+				if err != nil {
+					return err
+				}
+
+				var content string
+				switch {
+				case info.Mode()&os.ModeSymlink != 0:
+					content, err = os.Readlink(path)
+				case info.Mode().IsRegular():
+					var b []byte
+					b, err = os.ReadFile(path)
+					content = string(b)
+				}
+				if err != nil {
+					return err
+				}
+
+				entries[rel] = fmt.Sprintf("%s %s %s", info.Mode(), info.ModTime().UTC().Format(time.RFC3339), content)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			return entries
+		}
+
+		it.Before(func() {
+			var err error
+			otherLayersDir, err = os.MkdirTemp("", "layers")
+			Expect(err).NotTo(HaveOccurred())
+
+			deliveries = 0
+			dependencyManager.DeliverCall.Stub = func(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+				deliveries++
+
+				err := os.MkdirAll(filepath.Join(layerPath, "lib"), 0700)
+				if err != nil {
+					return err
+				}
+
+				err = os.WriteFile(filepath.Join(layerPath, "lib", "libicuuc.so.78.3"), []byte("library"), os.FileMode(0700|deliveries%2*010))
+				if err != nil {
+					return err
+				}
+
+				err = os.WriteFile(filepath.Join(layerPath, "lib", "icudt78l.dat"), []byte("data"), os.FileMode(0600|deliveries%2*040))
+				if err != nil {
+					return err
+				}
+
+				err = os.Symlink("libicuuc.so.78.3", filepath.Join(layerPath, "lib", "libicuuc.so"))
+				if err != nil {
+					return err
+				}
+
+				extracted := time.Now().Add(time.Duration(deliveries) * time.Hour)
+				return os.Chtimes(filepath.Join(layerPath, "lib", "icudt78l.dat"), extracted, extracted)
+			}
+		})
+
+		it.After(func() {
+			Expect(os.RemoveAll(otherLayersDir)).To(Succeed())
+		})
+
+		it("produces identical layer contents in every build", func() {
+			_, err := build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			buildContext.Layers.Path = otherLayersDir
+			_, err = build(buildContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(deliveries).To(Equal(2))
+
+			first := tree(filepath.Join(layersDir, "icu"))
+			Expect(first).To(HaveLen(5))
+			Expect(tree(filepath.Join(otherLayersDir, "icu"))).To(Equal(first))
+
+			Expect(first).To(HaveKeyWithValue("lib", "drwxr-xr-x 1980-01-01T00:00:01Z "))
+			Expect(first).To(HaveKeyWithValue(filepath.Join("lib", "libicuuc.so.78.3"), "-rwxr-xr-x 1980-01-01T00:00:01Z library"))
+			Expect(first).To(HaveKeyWithValue(filepath.Join("lib", "icudt78l.dat"), "-rw-r--r-- 1980-01-01T00:00:01Z data"))
+			Expect(first).To(HaveKeyWithValue(filepath.Join("lib", "libicuuc.so"), "Lrwxrwxrwx 1980-01-01T00:00:01Z libicuuc.so.78.3"))
+		})
+
+		context("when SOURCE_DATE_EPOCH is set", func() {
+			it.Before(func() {
+				t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+			})
+
+			it("uses it as the modification time", func() {
+				_, err := build(buildContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				for path, entry := range tree(filepath.Join(layersDir, "icu")) {
+					Expect(entry).To(ContainSubstring(" 2023-11-14T22:13:20Z "), path)
+				}
+			})
+		}, spec.Sequential())
+
+		context("when SOURCE_DATE_EPOCH is invalid", func() {
+			it.Before(func() {
+				t.Setenv("SOURCE_DATE_EPOCH", "yesterday")
+			})
+
+			it("returns an error", func() {
+				_, err := build(buildContext)
+				Expect(err).To(MatchError(ContainSubstring(`failed to parse SOURCE_DATE_EPOCH value "yesterday"`)))
+				Expect(deliveries).To(Equal(0))
+			})
+		}, spec.Sequential())
+	})
+
 	context("failure cases", func() {
 		context("when the ICU layer cannot be retrieved", func() {
 			it.Before(func() {
@@ -389,14 +2280,14 @@ func testBuild(t *testing.T, context spec.G, it spec.S) {
 			})
 		})
 
-		context("when the ICU layer cannot be reset", func() {
+		context("when the ICU layer cannot be staged", func() {
 			it.Before(func() {
-				Expect(os.MkdirAll(filepath.Join(layersDir, "icu", "something"), os.ModePerm)).To(Succeed())
-				Expect(os.Chmod(filepath.Join(layersDir, "icu"), 0500)).To(Succeed())
+				Expect(os.MkdirAll(filepath.Join(layersDir, ".icu.staging", "something"), os.ModePerm)).To(Succeed())
+				Expect(os.Chmod(filepath.Join(layersDir, ".icu.staging"), 0500)).To(Succeed())
 			})
 
 			it.After(func() {
-				Expect(os.Chmod(filepath.Join(layersDir, "icu"), os.ModePerm)).To(Succeed())
+				Expect(os.Chmod(filepath.Join(layersDir, ".icu.staging"), os.ModePerm)).To(Succeed())
 			})
 
 			it("returns an error", func() {
@@ -0,0 +1,123 @@
+package icu
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/cargo"
+	"github.com/paketo-buildpacks/packit/v2/chronos"
+	"github.com/paketo-buildpacks/packit/v2/sbom"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+// companion describes an artifact that is published alongside the ICU
+// dependency for the same version, stack and architecture, and installed into
+// its own layer.
+type companion struct {
+	DependencyID string
+	LayerName    string
+	Name         string
+
+	Build  bool
+	Launch bool
+
+	// Configure is called after the artifact has been delivered into the
+	// staging directory of the layer, and before the layer is committed, so that
+	// any file it rewrites is normalized along with the rest of the layer.
+	Configure func(layer *packit.Layer, stagingPath string) error
+}
+
+// installCompanion installs the companion artifact that matches the given ICU
+// version, reusing the cached layer when the dependency checksum is unchanged.
+func installCompanion(context packit.BuildContext,
+	transaction *layerTransaction,
+	dependencyManager DependencyManager,
+	sbomGenerator SBOMGenerator,
+	clock chronos.Clock,
+	logger scribe.Emitter,
+	c companion,
+	version string,
+) (packit.Layer, []packit.BOMEntry, error) {
+	dependency, err := dependencyManager.Resolve(filepath.Join(context.CNBPath, "buildpack.toml"), c.DependencyID, version, context.Stack)
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	dependency.Name = c.Name
+
+	layer, err := context.Layers.Get(c.LayerName)
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	bom := dependencyManager.GenerateBillOfMaterials(dependency)
+
+	cachedChecksum, ok := layer.Metadata["dependency-checksum"].(string)
+	if ok && cargo.Checksum(dependency.Checksum).MatchString(cachedChecksum) {
+		logger.Process("Reusing cached layer %s", layer.Path)
+		logger.Break()
+
+		layer.Launch, layer.Build, layer.Cache = c.Launch, c.Build, true
+
+		return layer, bom, nil
+	}
+
+	logger.Process("Executing build process")
+
+	layer, stagingPath, err := transaction.Stage(layer)
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	layer.Launch, layer.Build, layer.Cache = c.Launch, c.Build, true
+
+	logger.Subprocess("Installing %s %s", c.Name, dependency.Version)
+
+	duration, err := clock.Measure(func() error {
+		return dependencyManager.Deliver(dependency, context.CNBPath, stagingPath, context.Platform.Path)
+	})
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	logger.Action("Completed in %s", duration.Round(time.Millisecond))
+	logger.Break()
+
+	if c.Configure != nil {
+		err = c.Configure(&layer, stagingPath)
+		if err != nil {
+			return packit.Layer{}, nil, err
+		}
+	}
+
+	err = transaction.Commit(layer)
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	logger.GeneratingSBOM(layer.Path)
+	var sbomContent sbom.SBOM
+	duration, err = clock.Measure(func() error {
+		sbomContent, err = sbomGenerator.GenerateFromDependency(dependency, layer.Path)
+		return err
+	})
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	logger.Action("Completed in %s", duration.Round(time.Millisecond))
+	logger.Break()
+
+	logger.FormattingSBOM(context.BuildpackInfo.SBOMFormats...)
+	layer.SBOM, err = sbomContent.InFormats(context.BuildpackInfo.SBOMFormats...)
+	if err != nil {
+		return packit.Layer{}, nil, err
+	}
+
+	layer.Metadata = map[string]interface{}{
+		"dependency-checksum": dependency.Checksum,
+	}
+
+	return layer, bom, nil
+}
@@ -3,4 +3,16 @@ package icu
 const (
 	ICULayerName  = "icu"
 	ICUDependency = "icu"
+
+	ICUStaticLayerName  = "icu-static"
+	ICUStaticDependency = "icu-static"
+
+	ICUDebugLayerName  = "icu-debug"
+	ICUDebugDependency = "icu-debug"
+
+	ICUManifestDependency = "icu-manifest"
+
+	ICUOverridesLayerName = "icu-overrides"
+
+	ICUCacheLayerName = "icu-cache"
 )
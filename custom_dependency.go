@@ -0,0 +1,148 @@
+package icu
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+var customVersionPattern = regexp.MustCompile(`icu(?:4c)?[_-](\d+(?:[._]\d+)*)`)
+
+// customDependency builds the ICU dependency from BP_ICU_DEPENDENCY_URI and
+// BP_ICU_DEPENDENCY_CHECKSUM when they are set, so that an internally built
+// ICU can be installed instead of one listed in buildpack.toml. The version
+// is taken from BP_ICU_DEPENDENCY_VERSION or inferred from the file name.
+func customDependency() (postal.Dependency, bool, error) {
+	uri, ok := os.LookupEnv("BP_ICU_DEPENDENCY_URI")
+	if !ok || uri == "" {
+		return postal.Dependency{}, false, nil
+	}
+
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return postal.Dependency{}, false, fmt.Errorf("failed to parse BP_ICU_DEPENDENCY_URI value %q: %w", uri, err)
+	}
+
+	switch parsedURI.Scheme {
+	case "file", "https":
+	default:
+		return postal.Dependency{}, false, fmt.Errorf("failed to parse BP_ICU_DEPENDENCY_URI value %q: scheme must be file or https", uri)
+	}
+
+	checksum := os.Getenv("BP_ICU_DEPENDENCY_CHECKSUM")
+	if checksum == "" {
+		return postal.Dependency{}, false, fmt.Errorf("BP_ICU_DEPENDENCY_CHECKSUM must be set when BP_ICU_DEPENDENCY_URI is set")
+	}
+
+	// a bare hex digest is assumed to be a sha256 checksum, matching the
+	// checksums recorded in buildpack.toml
+	if !strings.Contains(checksum, ":") {
+		checksum = fmt.Sprintf("sha256:%s", checksum)
+	}
+
+	version := os.Getenv("BP_ICU_DEPENDENCY_VERSION")
+	if version == "" {
+		matches := customVersionPattern.FindStringSubmatch(path.Base(parsedURI.Path))
+		if matches == nil {
+			return postal.Dependency{}, false, fmt.Errorf("failed to determine the ICU version of %s: set BP_ICU_DEPENDENCY_VERSION", uri)
+		}
+
+		version = strings.ReplaceAll(matches[1], "_", ".")
+	}
+
+	_, digest, _ := strings.Cut(checksum, ":")
+
+	return postal.Dependency{
+		ID:       ICUDependency,
+		Name:     "ICU",
+		Version:  version,
+		URI:      uri,
+		Checksum: checksum,
+		CPE:      fmt.Sprintf(`cpe:2.3:a:icu-project:international_components_for_unicode:%s:*:*:*:*:c\/c\+\+:*:*`, version),
+		PURL:     fmt.Sprintf("pkg:generic/icu@%s?checksum=%s&download_url=%s", version, digest, uri),
+		Licenses: []string{"BSD-2-Clause", "BSD-3-Clause", "ICU", "Unicode-TOU"},
+	}, true, nil
+}
+
+// customKeyring returns the public keys provided through a binding of type
+// icu-keyring. Every entry of the binding is read as a key ring, in entry
+// name order.
+func customKeyring(bindingResolver BindingResolver, platformPath string) ([]byte, error) {
+	bindings, err := bindingResolver.Resolve("icu-keyring", "", platformPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve icu-keyring binding: %w", err)
+	}
+
+	if len(bindings) > 1 {
+		return nil, fmt.Errorf("failed to resolve icu-keyring binding: expected at most 1 binding but found %d", len(bindings))
+	}
+
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for name := range bindings[0].Entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keyring := bytes.NewBuffer(nil)
+	for _, name := range names {
+		content, err := bindings[0].Entries[name].ReadBytes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read icu-keyring binding: %w", err)
+		}
+
+		keyring.Write(content)
+		keyring.WriteString("\n")
+	}
+
+	return keyring.Bytes(), nil
+}
+
+// deliverCustomDependency verifies the custom dependency in a staging
+// directory and only then delivers the verified copy into the layer.
+func deliverCustomDependency(dependencyManager DependencyManager, dependencyVerifier DependencyVerifier, bindingResolver BindingResolver, logger scribe.Emitter, dependency postal.Dependency, layerPath, platformPath string) error {
+	signatureURI := os.Getenv("BP_ICU_DEPENDENCY_SIGNATURE_URI")
+
+	var keyring []byte
+	if signatureURI != "" {
+		var err error
+		keyring, err = customKeyring(bindingResolver, platformPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	stagingDir, err := os.MkdirTemp("", "icu-dependency")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	artifactPath, err := dependencyVerifier.Fetch(dependency, signatureURI, keyring, stagingDir)
+	if err != nil {
+		return err
+	}
+
+	logger.Action("Verified checksum %s", dependency.Checksum)
+	if signatureURI != "" {
+		logger.Action("Verified OpenPGP signature %s", signatureURI)
+	}
+
+	// the verified copy is delivered from an absolute file:// URI, which the
+	// transport resolves relative to the root directory
+	verified := dependency
+	verified.URI = fmt.Sprintf("file://%s", artifactPath)
+
+	return dependencyManager.Deliver(verified, "/", layerPath, platformPath)
+}
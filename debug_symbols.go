@@ -0,0 +1,45 @@
+package icu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+// debugSymbolsRequested reports whether BP_ICU_DEBUG_SYMBOLS is enabled.
+func debugSymbolsRequested() (bool, error) {
+	value, ok := os.LookupEnv("BP_ICU_DEBUG_SYMBOLS")
+	if !ok {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse BP_ICU_DEBUG_SYMBOLS value %q: %w", value, err)
+	}
+
+	return enabled, nil
+}
+
+// debugSymbolsCompanion installs the separated ICU debug files into a launch
+// layer. The files are laid out under lib/debug/.build-id so that debuggers
+// can locate them by the GNU build-ID of the stripped libraries.
+func debugSymbolsCompanion(logger scribe.Emitter) companion {
+	return companion{
+		DependencyID: ICUDebugDependency,
+		LayerName:    ICUDebugLayerName,
+		Name:         "ICU (debug symbols)",
+		Launch:       true,
+		Configure: func(layer *packit.Layer, _ string) error {
+			logger.Subprocess("Debug symbols are available in %s", filepath.Join(layer.Path, "lib", "debug"))
+			logger.Action("Add this directory to the debug-file-directory setting of your debugger")
+			logger.Break()
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,624 @@
+package components
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const inTotoPayloadType = "application/vnd.in-toto+json"
+
+var (
+	inTotoStatementTypes = []string{"https://in-toto.io/Statement/v1", "https://in-toto.io/Statement/v0.1"}
+
+	// The Fulcio certificate extensions that hold the OIDC issuer of the
+	// identity that the certificate was issued to.
+	oidcIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidcIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// TrustRoot holds the certificate authorities that issue signing certificates
+// and the keys of the transparency logs that record signatures, as listed by
+// a Sigstore trusted_root.json file.
+type TrustRoot struct {
+	authorities []certificateAuthority
+	logs        map[string]transparencyLog
+}
+
+type certificateAuthority struct {
+	roots         *x509.CertPool
+	intermediates []*x509.Certificate
+	validFor      validity
+}
+
+type transparencyLog struct {
+	key      crypto.PublicKey
+	validFor validity
+}
+
+type validity struct {
+	Start time.Time  `json:"start"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+func (v validity) contains(t time.Time) bool {
+	return !t.Before(v.Start) && (v.End == nil || !t.After(*v.End))
+}
+
+type rawBytes struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+// LoadTrustRoot reads a trust root in the format of the Sigstore
+// trusted_root.json file.
+func LoadTrustRoot(path string) (TrustRoot, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return TrustRoot{}, fmt.Errorf("failed to load trust root: %w", err)
+	}
+
+	return ParseTrustRoot(content)
+}
+
+// ParseTrustRoot parses a trust root in the format of the Sigstore
+// trusted_root.json file. Certificate chains list the issuing certificate
+// first and the root certificate last.
+func ParseTrustRoot(content []byte) (TrustRoot, error) {
+	var document struct {
+		Tlogs []struct {
+			PublicKey struct {
+				rawBytes
+				ValidFor validity `json:"validFor"`
+			} `json:"publicKey"`
+			LogID struct {
+				KeyID []byte `json:"keyId"`
+			} `json:"logId"`
+		} `json:"tlogs"`
+		CertificateAuthorities []struct {
+			CertChain struct {
+				Certificates []rawBytes `json:"certificates"`
+			} `json:"certChain"`
+			ValidFor validity `json:"validFor"`
+		} `json:"certificateAuthorities"`
+	}
+
+	err := json.Unmarshal(content, &document)
+	if err != nil {
+		return TrustRoot{}, fmt.Errorf("failed to parse trust root: %w", err)
+	}
+
+	root := TrustRoot{logs: map[string]transparencyLog{}}
+	for _, tlog := range document.Tlogs {
+		key, err := x509.ParsePKIXPublicKey(tlog.PublicKey.RawBytes)
+		if err != nil {
+			return TrustRoot{}, fmt.Errorf("failed to parse trust root: transparency log %x: %w", tlog.LogID.KeyID, err)
+		}
+
+		root.logs[hex.EncodeToString(tlog.LogID.KeyID)] = transparencyLog{key: key, validFor: tlog.PublicKey.ValidFor}
+	}
+
+	for _, authority := range document.CertificateAuthorities {
+		certificates := authority.CertChain.Certificates
+		if len(certificates) == 0 {
+			return TrustRoot{}, fmt.Errorf("failed to parse trust root: certificate authority without certificates")
+		}
+
+		ca := certificateAuthority{
+			roots:    x509.NewCertPool(),
+			validFor: authority.ValidFor,
+		}
+		for i, raw := range certificates {
+			certificate, err := x509.ParseCertificate(raw.RawBytes)
+			if err != nil {
+				return TrustRoot{}, fmt.Errorf("failed to parse trust root: %w", err)
+			}
+
+			if i == len(certificates)-1 {
+				ca.roots.AddCert(certificate)
+			} else {
+				ca.intermediates = append(ca.intermediates, certificate)
+			}
+		}
+
+		root.authorities = append(root.authorities, ca)
+	}
+
+	if len(root.logs) == 0 || len(root.authorities) == 0 {
+		return TrustRoot{}, fmt.Errorf("failed to parse trust root: at least one transparency log and certificate authority are required")
+	}
+
+	return root, nil
+}
+
+// AttestationVerifier checks Sigstore bundles that hold an in-toto statement
+// with SLSA provenance for a release artifact. The bundle is verified offline:
+// its signing certificate must chain to a certificate authority of the trust
+// root, and the signature must be recorded in a transparency log of the trust
+// root, as shown by a signed entry timestamp. Bundles signed with a public key
+// instead of a certificate, and entries that only carry an inclusion proof,
+// are not supported.
+type AttestationVerifier struct {
+	trustRoot TrustRoot
+	identity  string
+	issuer    string
+}
+
+func NewAttestationVerifier(trustRoot TrustRoot) AttestationVerifier {
+	return AttestationVerifier{
+		trustRoot: trustRoot,
+	}
+}
+
+// WithIdentity requires the signing certificate to be issued to the given
+// identity, an email address or URI, and by the given OIDC issuer. Both are
+// required: Verify rejects every bundle until they are set, as any Fulcio
+// certificate would chain to the trust root.
+func (v AttestationVerifier) WithIdentity(identity, issuer string) AttestationVerifier {
+	v.identity = identity
+	v.issuer = issuer
+	return v
+}
+
+type sigstoreBundle struct {
+	VerificationMaterial struct {
+		Certificate          *rawBytes `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []rawBytes `json:"certificates"`
+		} `json:"x509CertificateChain"`
+		TlogEntries []tlogEntry `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+	DsseEnvelope *struct {
+		Payload     []byte `json:"payload"`
+		PayloadType string `json:"payloadType"`
+		Signatures  []struct {
+			Sig []byte `json:"sig"`
+		} `json:"signatures"`
+	} `json:"dsseEnvelope"`
+}
+
+type tlogEntry struct {
+	LogIndex int64 `json:"logIndex,string"`
+	LogID    struct {
+		KeyID []byte `json:"keyId"`
+	} `json:"logId"`
+	IntegratedTime   int64 `json:"integratedTime,string"`
+	InclusionPromise *struct {
+		SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+	} `json:"inclusionPromise"`
+	CanonicalizedBody []byte `json:"canonicalizedBody"`
+}
+
+type inTotoStatement struct {
+	Type    string `json:"_type"`
+	Subject []struct {
+		Name   string            `json:"name"`
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+	PredicateType string `json:"predicateType"`
+}
+
+// Verify checks the Sigstore bundle against the trust root, and that the
+// in-toto statement it holds names the target as a subject by its sha256 or
+// sha512 digest. The result gives the SHA-256 fingerprint of the signing
+// certificate as the fingerprint, the identity it was issued to as the user ID,
+// and the time the transparency log recorded the signature as the creation
+// time.
+func (v AttestationVerifier) Verify(bundle io.Reader, target io.ReadSeeker) (VerificationResult, error) {
+	if v.identity == "" || v.issuer == "" {
+		return VerificationResult{}, errors.New("failed to verify attestation: a certificate identity and issuer are required")
+	}
+
+	var b sigstoreBundle
+	err := json.NewDecoder(bundle).Decode(&b)
+	if err != nil {
+		return VerificationResult{}, fmt.Errorf("failed to parse attestation bundle: %w", err)
+	}
+
+	envelope := b.DsseEnvelope
+	if envelope == nil || envelope.PayloadType != inTotoPayloadType {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: the bundle does not hold an in-toto statement")
+	}
+
+	var chain [][]byte
+	switch {
+	case b.VerificationMaterial.Certificate != nil:
+		chain = append(chain, b.VerificationMaterial.Certificate.RawBytes)
+	case b.VerificationMaterial.X509CertificateChain != nil:
+		for _, certificate := range b.VerificationMaterial.X509CertificateChain.Certificates {
+			chain = append(chain, certificate.RawBytes)
+		}
+	}
+	if len(chain) == 0 {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: the bundle has no signing certificate")
+	}
+
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: %w", err)
+	}
+
+	var intermediates []*x509.Certificate
+	for _, raw := range chain[1:] {
+		certificate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return VerificationResult{}, fmt.Errorf("failed to verify attestation: %w", err)
+		}
+		intermediates = append(intermediates, certificate)
+	}
+
+	pae := preAuthenticationEncoding(envelope.PayloadType, envelope.Payload)
+
+	var (
+		hash      crypto.Hash
+		signature []byte
+	)
+	for _, s := range envelope.Signatures {
+		hash, err = verifySignature(leaf.PublicKey, pae, s.Sig)
+		if err == nil {
+			signature = s.Sig
+			break
+		}
+	}
+	if signature == nil {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: no signature of the bundle was made by its signing certificate")
+	}
+
+	integratedTime, err := v.verifyTlogEntries(b.VerificationMaterial.TlogEntries, envelope.Payload, signature, leaf)
+	if err != nil {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: %w", err)
+	}
+
+	err = v.verifyCertificate(leaf, intermediates, integratedTime)
+	if err != nil {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: %w", err)
+	}
+
+	identity, issuer := certificateIdentity(leaf)
+	if identity != v.identity {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: the certificate was issued to %q, not %q", identity, v.identity)
+	}
+	if issuer != v.issuer {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: the certificate was issued by %q, not %q", issuer, v.issuer)
+	}
+
+	var statement inTotoStatement
+	err = json.Unmarshal(envelope.Payload, &statement)
+	if err != nil {
+		return VerificationResult{}, fmt.Errorf("failed to parse in-toto statement: %w", err)
+	}
+
+	if !slices.Contains(inTotoStatementTypes, statement.Type) {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: unsupported statement type %q", statement.Type)
+	}
+
+	if !strings.HasPrefix(statement.PredicateType, "https://slsa.dev/provenance/") {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: unsupported predicate type %q", statement.PredicateType)
+	}
+
+	_, err = target.Seek(0, io.SeekStart)
+	if err != nil {
+		return VerificationResult{}, err
+	}
+
+	sha256Hash, sha512Hash := sha256.New(), sha512.New()
+	_, err = io.Copy(io.MultiWriter(sha256Hash, sha512Hash), target)
+	if err != nil {
+		return VerificationResult{}, err
+	}
+	digests := map[string]string{
+		"sha256": hex.EncodeToString(sha256Hash.Sum(nil)),
+		"sha512": hex.EncodeToString(sha512Hash.Sum(nil)),
+	}
+
+	if !statement.names(digests) {
+		return VerificationResult{}, fmt.Errorf("failed to verify attestation: the statement has no subject with the digest of the target")
+	}
+
+	fingerprint := sha256.Sum256(leaf.Raw)
+
+	return VerificationResult{
+		Fingerprint:   Fingerprint(fingerprint[:]),
+		UserID:        identity,
+		CreationTime:  integratedTime.UTC(),
+		HashAlgorithm: hash.String(),
+		Issuer:        issuer,
+		PredicateType: statement.PredicateType,
+	}, nil
+}
+
+// names returns whether a subject of the statement has the given digests, for
+// every algorithm that both list and at least one.
+func (s inTotoStatement) names(digests map[string]string) bool {
+	for _, subject := range s.Subject {
+		matched := 0
+		for algorithm, digest := range subject.Digest {
+			expected, ok := digests[strings.ToLower(algorithm)]
+			if !ok {
+				continue
+			}
+
+			if !strings.EqualFold(expected, digest) {
+				matched = 0
+				break
+			}
+			matched++
+		}
+
+		if matched > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyTlogEntries returns the time that a transparency log of the trust root
+// recorded the signature at, from the first entry whose signed entry timestamp
+// verifies and whose body records the payload, and the signature along with
+// the certificate that made it.
+func (v AttestationVerifier) verifyTlogEntries(entries []tlogEntry, payload, signature []byte, leaf *x509.Certificate) (time.Time, error) {
+	if len(entries) == 0 {
+		return time.Time{}, errors.New("the bundle has no transparency log entries")
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		err := v.verifyTlogEntry(entry, payload, signature, leaf)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("transparency log entry %d: %w", entry.LogIndex, err))
+			continue
+		}
+
+		return time.Unix(entry.IntegratedTime, 0), nil
+	}
+
+	return time.Time{}, errors.Join(errs...)
+}
+
+func (v AttestationVerifier) verifyTlogEntry(entry tlogEntry, payload, signature []byte, leaf *x509.Certificate) error {
+	logID := hex.EncodeToString(entry.LogID.KeyID)
+	tlog, ok := v.trustRoot.logs[logID]
+	if !ok {
+		return fmt.Errorf("the log %s is not in the trust root", logID)
+	}
+
+	integratedTime := time.Unix(entry.IntegratedTime, 0)
+	if !tlog.validFor.contains(integratedTime) {
+		return fmt.Errorf("the log key was not valid at %s", integratedTime.UTC().Format(time.RFC3339))
+	}
+
+	if entry.InclusionPromise == nil {
+		return errors.New("the entry has no signed entry timestamp")
+	}
+
+	// The signed entry timestamp signs the canonical JSON of these fields. The
+	// keys of a map are marshalled in sorted order, and the values need no
+	// escaping, so the output is canonical whatever order they are listed in.
+	promise, err := json.Marshal(map[string]interface{}{
+		"body":           base64.StdEncoding.EncodeToString(entry.CanonicalizedBody),
+		"integratedTime": entry.IntegratedTime,
+		"logID":          logID,
+		"logIndex":       entry.LogIndex,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = verifySignature(tlog.key, promise, entry.InclusionPromise.SignedEntryTimestamp)
+	if err != nil {
+		return fmt.Errorf("the signed entry timestamp does not verify: %w", err)
+	}
+
+	var body struct {
+		Kind string `json:"kind"`
+		Spec struct {
+			PayloadHash *struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"payloadHash"`
+			Signatures []struct {
+				Signature []byte `json:"signature"`
+				Verifier  []byte `json:"verifier"`
+			} `json:"signatures"`
+			Content struct {
+				Envelope struct {
+					Signatures []struct {
+						Sig       []byte `json:"sig"`
+						PublicKey []byte `json:"publicKey"`
+					} `json:"signatures"`
+				} `json:"envelope"`
+				PayloadHash *struct {
+					Algorithm string `json:"algorithm"`
+					Value     string `json:"value"`
+				} `json:"payloadHash"`
+			} `json:"content"`
+		} `json:"spec"`
+	}
+	err = json.Unmarshal(entry.CanonicalizedBody, &body)
+	if err != nil {
+		return fmt.Errorf("failed to parse the entry: %w", err)
+	}
+
+	// Both kinds record the signatures along with the PEM-encoded certificate
+	// that made them. The intoto kind encodes the signatures of its envelope
+	// in base64 once more.
+	var recorded []recordedSignature
+	payloadHash := body.Spec.PayloadHash
+	switch body.Kind {
+	case "dsse":
+		for _, s := range body.Spec.Signatures {
+			recorded = append(recorded, recordedSignature{signature: s.Signature, verifier: s.Verifier})
+		}
+
+	case "intoto":
+		payloadHash = body.Spec.Content.PayloadHash
+		for _, s := range body.Spec.Content.Envelope.Signatures {
+			decoded, err := base64.StdEncoding.DecodeString(string(s.Sig))
+			if err != nil {
+				continue
+			}
+			recorded = append(recorded, recordedSignature{signature: decoded, verifier: s.PublicKey})
+		}
+
+	default:
+		return fmt.Errorf("unsupported entry kind %q", body.Kind)
+	}
+
+	var signatureRecorded, certificateRecorded bool
+	for _, r := range recorded {
+		if !bytes.Equal(r.signature, signature) {
+			continue
+		}
+		signatureRecorded = true
+
+		if block, _ := pem.Decode(r.verifier); block != nil && bytes.Equal(block.Bytes, leaf.Raw) {
+			certificateRecorded = true
+			break
+		}
+	}
+	if !signatureRecorded {
+		return errors.New("the entry does not record the signature of the bundle")
+	}
+	if !certificateRecorded {
+		return errors.New("the entry does not record the signing certificate of the bundle")
+	}
+
+	sum := sha256.Sum256(payload)
+	if payloadHash == nil || payloadHash.Algorithm != "sha256" || !strings.EqualFold(payloadHash.Value, hex.EncodeToString(sum[:])) {
+		return errors.New("the entry does not record the payload of the bundle")
+	}
+
+	return nil
+}
+
+type recordedSignature struct {
+	signature []byte
+	verifier  []byte
+}
+
+// verifyCertificate checks that the signing certificate chains to a
+// certificate authority of the trust root, and was valid when the signature
+// was recorded.
+func (v AttestationVerifier) verifyCertificate(leaf *x509.Certificate, intermediates []*x509.Certificate, at time.Time) error {
+	var errs []error
+	for _, authority := range v.trustRoot.authorities {
+		if !authority.validFor.contains(at) {
+			continue
+		}
+
+		pool := x509.NewCertPool()
+		for _, certificate := range append(intermediates, authority.intermediates...) {
+			pool.AddCert(certificate)
+		}
+
+		_, err := leaf.Verify(x509.VerifyOptions{
+			Roots:         authority.roots,
+			Intermediates: pool,
+			CurrentTime:   at,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		})
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("no certificate authority of the trust root was valid at %s", at.UTC().Format(time.RFC3339))
+	}
+
+	return fmt.Errorf("the signing certificate does not chain to the trust root: %w", errors.Join(errs...))
+}
+
+// certificateIdentity returns the email address or URI that a Fulcio
+// certificate was issued to, and the OIDC issuer that vouched for it.
+func certificateIdentity(certificate *x509.Certificate) (string, string) {
+	var identity string
+	switch {
+	case len(certificate.EmailAddresses) > 0:
+		identity = certificate.EmailAddresses[0]
+	case len(certificate.URIs) > 0:
+		identity = certificate.URIs[0].String()
+	}
+
+	var issuer string
+	for _, extension := range certificate.Extensions {
+		switch {
+		case extension.Id.Equal(oidcIssuerV2):
+			var value string
+			if _, err := asn1.Unmarshal(extension.Value, &value); err == nil {
+				issuer = value
+			}
+
+		case extension.Id.Equal(oidcIssuerV1) && issuer == "":
+			issuer = string(extension.Value)
+		}
+	}
+
+	return identity, issuer
+}
+
+// preAuthenticationEncoding returns the bytes that a DSSE signature signs.
+func preAuthenticationEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// verifySignature checks a signature made with the given ECDSA, Ed25519 or
+// RSA key, and returns the hash that it was made over.
+func verifySignature(key crypto.PublicKey, data, signature []byte) (crypto.Hash, error) {
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		hash := crypto.SHA256
+		switch key.Curve {
+		case elliptic.P384():
+			hash = crypto.SHA384
+		case elliptic.P521():
+			hash = crypto.SHA512
+		}
+
+		h := hash.New()
+		h.Write(data)
+		if !ecdsa.VerifyASN1(key, h.Sum(nil), signature) {
+			return 0, errors.New("invalid signature")
+		}
+
+		return hash, nil
+
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return 0, errors.New("invalid signature")
+		}
+
+		return crypto.SHA512, nil
+
+	case *rsa.PublicKey:
+		sum := sha256.Sum256(data)
+		err := rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], signature)
+		if err != nil {
+			return 0, err
+		}
+
+		return crypto.SHA256, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -0,0 +1,568 @@
+package components_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testAttestation(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		identity = "https://github.com/unicode-org/icu/.github/workflows/release.yml@refs/tags/release-74-2"
+		issuer   = "https://token.actions.githubusercontent.com"
+
+		source []byte
+		now    time.Time
+
+		caKey     *ecdsa.PrivateKey
+		caCert    *x509.Certificate
+		rootCert  []byte
+		interCert []byte
+		tlogKey   *ecdsa.PrivateKey
+
+		signingKey     crypto.Signer
+		leafCert       []byte
+		statement      map[string]interface{}
+		integratedTime time.Time
+		setKey         *ecdsa.PrivateKey
+
+		// the kind of the transparency log entry, and the signature and
+		// certificate it records when they differ from those of the bundle
+		entryKind        string
+		entrySignature   []byte
+		entryCertificate []byte
+
+		trustRoot components.TrustRoot
+		verifier  components.AttestationVerifier
+
+		newCertificate = func(template *x509.Certificate, parent *x509.Certificate, key crypto.PublicKey, parentKey crypto.Signer) []byte {
+			serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+			Expect(err).NotTo(HaveOccurred())
+			template.SerialNumber = serial
+
+			if parent == nil {
+				parent = template
+			}
+
+			certificate, err := x509.CreateCertificate(rand.Reader, template, parent, key, parentKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			return certificate
+		}
+
+		newLeafCertificate = func(key crypto.PublicKey, notBefore time.Time) []byte {
+			issuerExtension, err := asn1.Marshal(issuer)
+			Expect(err).NotTo(HaveOccurred())
+
+			uri, err := url.Parse(identity)
+			Expect(err).NotTo(HaveOccurred())
+
+			return newCertificate(&x509.Certificate{
+				Subject:     pkix.Name{},
+				NotBefore:   notBefore,
+				NotAfter:    notBefore.Add(10 * time.Minute),
+				KeyUsage:    x509.KeyUsageDigitalSignature,
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+				URIs:        []*url.URL{uri},
+				ExtraExtensions: []pkix.Extension{
+					{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}, Value: issuerExtension},
+				},
+			}, caCert, key, caKey)
+		}
+
+		logID = func(key *ecdsa.PrivateKey) []byte {
+			der, err := x509.MarshalPKIXPublicKey(key.Public())
+			Expect(err).NotTo(HaveOccurred())
+
+			sum := sha256.Sum256(der)
+			return sum[:]
+		}
+
+		sign = func(key crypto.Signer, data []byte) []byte {
+			if _, ok := key.(ed25519.PrivateKey); ok {
+				signature, err := key.Sign(rand.Reader, data, crypto.Hash(0))
+				Expect(err).NotTo(HaveOccurred())
+
+				return signature
+			}
+
+			sum := sha256.Sum256(data)
+			signature, err := key.Sign(rand.Reader, sum[:], crypto.SHA256)
+			Expect(err).NotTo(HaveOccurred())
+
+			return signature
+		}
+
+		// bundle returns a Sigstore bundle of the statement, signed with the
+		// signing key and recorded in the transparency log of the trust root.
+		bundle = func() []byte {
+			payload, err := json.Marshal(statement)
+			Expect(err).NotTo(HaveOccurred())
+
+			payloadType := "application/vnd.in-toto+json"
+			signature := sign(signingKey, []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)))
+
+			recordedSignature, recordedCertificate := signature, leafCert
+			if entrySignature != nil {
+				recordedSignature = entrySignature
+			}
+			if entryCertificate != nil {
+				recordedCertificate = entryCertificate
+			}
+			verifier := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: recordedCertificate})
+
+			payloadHash := map[string]string{"algorithm": "sha256", "value": fmt.Sprintf("%x", sha256.Sum256(payload))}
+
+			var spec map[string]interface{}
+			switch entryKind {
+			case "intoto":
+				spec = map[string]interface{}{
+					"content": map[string]interface{}{
+						"envelope": map[string]interface{}{
+							"payloadType": payloadType,
+							"signatures":  []map[string]interface{}{{"sig": []byte(base64.StdEncoding.EncodeToString(recordedSignature)), "publicKey": verifier}},
+						},
+						"payloadHash": payloadHash,
+					},
+				}
+			default:
+				spec = map[string]interface{}{
+					"payloadHash": payloadHash,
+					"signatures":  []map[string]interface{}{{"signature": recordedSignature, "verifier": verifier}},
+				}
+			}
+
+			apiVersion := "0.0.1"
+			if entryKind == "intoto" {
+				apiVersion = "0.0.2"
+			}
+
+			body, err := json.Marshal(map[string]interface{}{
+				"apiVersion": apiVersion,
+				"kind":       entryKind,
+				"spec":       spec,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			promise, err := json.Marshal(map[string]interface{}{
+				"body":           base64.StdEncoding.EncodeToString(body),
+				"integratedTime": integratedTime.Unix(),
+				"logID":          hex.EncodeToString(logID(tlogKey)),
+				"logIndex":       42,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := json.Marshal(map[string]interface{}{
+				"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+				"verificationMaterial": map[string]interface{}{
+					"certificate": map[string]interface{}{"rawBytes": leafCert},
+					"tlogEntries": []map[string]interface{}{
+						{
+							"logIndex":          "42",
+							"logId":             map[string]interface{}{"keyId": logID(tlogKey)},
+							"kindVersion":       map[string]string{"kind": entryKind, "version": apiVersion},
+							"integratedTime":    fmt.Sprintf("%d", integratedTime.Unix()),
+							"inclusionPromise":  map[string]interface{}{"signedEntryTimestamp": sign(setKey, promise)},
+							"canonicalizedBody": body,
+						},
+					},
+				},
+				"dsseEnvelope": map[string]interface{}{
+					"payload":     payload,
+					"payloadType": payloadType,
+					"signatures":  []map[string]interface{}{{"sig": signature, "keyid": ""}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			return content
+		}
+
+		trustedRoot = func() []byte {
+			tlogPublicKey, err := x509.MarshalPKIXPublicKey(tlogKey.Public())
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := json.Marshal(map[string]interface{}{
+				"mediaType": "application/vnd.dev.sigstore.trustedroot+json;version=0.1",
+				"tlogs": []map[string]interface{}{
+					{
+						"baseUrl":       "https://rekor.example.com",
+						"hashAlgorithm": "SHA2_256",
+						"publicKey": map[string]interface{}{
+							"rawBytes":   tlogPublicKey,
+							"keyDetails": "PKIX_ECDSA_P256_SHA_256",
+							"validFor":   map[string]interface{}{"start": now.Add(-24 * time.Hour)},
+						},
+						"logId": map[string]interface{}{"keyId": logID(tlogKey)},
+					},
+				},
+				"certificateAuthorities": []map[string]interface{}{
+					{
+						"uri": "https://fulcio.example.com",
+						"certChain": map[string]interface{}{
+							"certificates": []map[string]interface{}{{"rawBytes": interCert}, {"rawBytes": rootCert}},
+						},
+						"validFor": map[string]interface{}{"start": now.Add(-24 * time.Hour)},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			return content
+		}
+	)
+
+	it.Before(func() {
+		var err error
+		source = []byte("some-source")
+		now = time.Now().Truncate(time.Second)
+
+		rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		rootTemplate := &x509.Certificate{
+			Subject:               pkix.Name{CommonName: "sigstore"},
+			NotBefore:             now.Add(-24 * time.Hour),
+			NotAfter:              now.Add(24 * time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		rootCert = newCertificate(rootTemplate, nil, rootKey.Public(), rootKey)
+		root, err := x509.ParseCertificate(rootCert)
+		Expect(err).NotTo(HaveOccurred())
+
+		caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		interCert = newCertificate(&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "sigstore-intermediate"},
+			NotBefore:             now.Add(-24 * time.Hour),
+			NotAfter:              now.Add(24 * time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}, root, caKey.Public(), rootKey)
+		caCert, err = x509.ParseCertificate(interCert)
+		Expect(err).NotTo(HaveOccurred())
+
+		tlogKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		setKey = tlogKey
+
+		entryKind = "dsse"
+		entrySignature = nil
+		entryCertificate = nil
+
+		leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		signingKey = leafKey
+		leafCert = newLeafCertificate(leafKey.Public(), now.Add(-time.Minute))
+		integratedTime = now
+
+		sum := sha512.Sum512(source)
+		statement = map[string]interface{}{
+			"_type": "https://in-toto.io/Statement/v1",
+			"subject": []map[string]interface{}{
+				{"name": "icu4c-74_2-src.tgz", "digest": map[string]string{"sha512": hex.EncodeToString(sum[:])}},
+			},
+			"predicateType": "https://slsa.dev/provenance/v1",
+			"predicate":     map[string]interface{}{"buildDefinition": map[string]interface{}{"buildType": "https://actions.github.io/buildtypes/workflow/v1"}},
+		}
+
+		trustRoot, err = components.ParseTrustRoot(trustedRoot())
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier = components.NewAttestationVerifier(trustRoot).WithIdentity(identity, issuer)
+	})
+
+	context("Verify", func() {
+		it("verifies the bundle and returns the identity of the certificate", func() {
+			result, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
+
+			fingerprint := sha256.Sum256(leafCert)
+			Expect(result).To(Equal(components.VerificationResult{
+				Fingerprint:   components.Fingerprint(fingerprint[:]),
+				UserID:        identity,
+				CreationTime:  now.UTC(),
+				HashAlgorithm: "SHA-256",
+				Issuer:        issuer,
+				PredicateType: "https://slsa.dev/provenance/v1",
+			}))
+		})
+
+		it("verifies a bundle signed with an Ed25519 key", func() {
+			_, leafKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			signingKey = leafKey
+			leafCert = newLeafCertificate(leafKey.Public(), now.Add(-time.Minute))
+
+			result, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.HashAlgorithm).To(Equal("SHA-512"))
+		})
+
+		it("verifies a bundle recorded as an intoto entry", func() {
+			entryKind = "intoto"
+
+			_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("verifies a statement that names the target by its sha256 digest", func() {
+			sum := sha256.Sum256(source)
+			statement["subject"] = []map[string]interface{}{
+				{"name": "other", "digest": map[string]string{"sha256": "0000"}},
+				{"name": "icu4c-74_2-src.tgz", "digest": map[string]string{"sha256": hex.EncodeToString(sum[:]), "gitCommit": "abc"}},
+			}
+
+			_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		context("when the identity is required", func() {
+			it("rejects a bundle of another identity", func() {
+				_, err := verifier.WithIdentity("https://github.com/other/repo/.github/workflows/release.yml@refs/heads/main", issuer).Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+				Expect(err).To(MatchError(fmt.Sprintf(`failed to verify attestation: the certificate was issued to %q, not "https://github.com/other/repo/.github/workflows/release.yml@refs/heads/main"`, identity)))
+			})
+
+			it("rejects a bundle of another issuer", func() {
+				_, err := verifier.WithIdentity(identity, "https://accounts.example.com").Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+				Expect(err).To(MatchError(fmt.Sprintf(`failed to verify attestation: the certificate was issued by %q, not "https://accounts.example.com"`, issuer)))
+			})
+
+			it("rejects every bundle when the identity or issuer is not set", func() {
+				_, err := components.NewAttestationVerifier(trustRoot).Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+				Expect(err).To(MatchError("failed to verify attestation: a certificate identity and issuer are required"))
+
+				_, err = verifier.WithIdentity(identity, "").Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+				Expect(err).To(MatchError("failed to verify attestation: a certificate identity and issuer are required"))
+			})
+		})
+
+		context("failure cases", func() {
+			context("when the statement does not name the target", func() {
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader([]byte("other-source")))
+					Expect(err).To(MatchError("failed to verify attestation: the statement has no subject with the digest of the target"))
+				})
+			})
+
+			context("when the predicate is not SLSA provenance", func() {
+				it.Before(func() {
+					statement["predicateType"] = "https://spdx.dev/Document"
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError(`failed to verify attestation: unsupported predicate type "https://spdx.dev/Document"`))
+				})
+			})
+
+			context("when the statement type is not in-toto", func() {
+				it.Before(func() {
+					statement["_type"] = "https://example.com/Statement"
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError(`failed to verify attestation: unsupported statement type "https://example.com/Statement"`))
+				})
+			})
+
+			context("when the envelope is not signed by the certificate", func() {
+				it.Before(func() {
+					otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+					signingKey = otherKey
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: no signature of the bundle was made by its signing certificate"))
+				})
+			})
+
+			context("when the certificate is not issued by the trust root", func() {
+				it.Before(func() {
+					otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+
+					caCert, err = x509.ParseCertificate(newCertificate(&x509.Certificate{
+						Subject:               pkix.Name{CommonName: "other"},
+						NotBefore:             now.Add(-time.Hour),
+						NotAfter:              now.Add(time.Hour),
+						KeyUsage:              x509.KeyUsageCertSign,
+						BasicConstraintsValid: true,
+						IsCA:                  true,
+					}, nil, otherKey.Public(), otherKey))
+					Expect(err).NotTo(HaveOccurred())
+					caKey = otherKey
+
+					leafCert = newLeafCertificate(signingKey.Public(), now.Add(-time.Minute))
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError(ContainSubstring("failed to verify attestation: the signing certificate does not chain to the trust root")))
+				})
+			})
+
+			context("when the signature was recorded after the certificate expired", func() {
+				it.Before(func() {
+					integratedTime = now.Add(time.Hour)
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError(ContainSubstring("certificate has expired or is not yet valid")))
+				})
+			})
+
+			context("when the transparency log is not in the trust root", func() {
+				it.Before(func() {
+					otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+					tlogKey = otherKey
+					setKey = otherKey
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError(fmt.Sprintf("failed to verify attestation: transparency log entry 42: the log %x is not in the trust root", logID(tlogKey))))
+				})
+			})
+
+			context("when the signed entry timestamp is not signed by the log", func() {
+				it.Before(func() {
+					otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+					setKey = otherKey
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: transparency log entry 42: the signed entry timestamp does not verify: invalid signature"))
+				})
+			})
+
+			context("when the transparency log entry records another signature", func() {
+				it.Before(func() {
+					entrySignature = []byte("some-other-signature")
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: transparency log entry 42: the entry does not record the signature of the bundle"))
+				})
+			})
+
+			context("when the transparency log entry records another certificate", func() {
+				it.Before(func() {
+					otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+					entryCertificate = newLeafCertificate(otherKey.Public(), now.Add(-time.Minute))
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: transparency log entry 42: the entry does not record the signing certificate of the bundle"))
+				})
+			})
+
+			context("when the intoto transparency log entry records another signature", func() {
+				it.Before(func() {
+					entryKind = "intoto"
+					entrySignature = []byte("some-other-signature")
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: transparency log entry 42: the entry does not record the signature of the bundle"))
+				})
+			})
+
+			context("when the intoto transparency log entry records another certificate", func() {
+				it.Before(func() {
+					entryKind = "intoto"
+
+					otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+					entryCertificate = newLeafCertificate(otherKey.Public(), now.Add(-time.Minute))
+				})
+
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: transparency log entry 42: the entry does not record the signing certificate of the bundle"))
+				})
+			})
+
+			context("when the bundle does not hold an in-toto statement", func() {
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader([]byte(`{"messageSignature": {}}`)), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to verify attestation: the bundle does not hold an in-toto statement"))
+				})
+			})
+
+			context("when the bundle is not JSON", func() {
+				it("returns an error", func() {
+					_, err := verifier.Verify(bytes.NewReader([]byte("some-signature")), bytes.NewReader(source))
+					Expect(err).To(MatchError(ContainSubstring("failed to parse attestation bundle")))
+				})
+			})
+		})
+	})
+
+	context("LoadTrustRoot", func() {
+		it("reads the trust root", func() {
+			path := filepath.Join(t.TempDir(), "trusted_root.json")
+			Expect(os.WriteFile(path, trustedRoot(), 0644)).To(Succeed())
+
+			root, err := components.LoadTrustRoot(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = components.NewAttestationVerifier(root).WithIdentity(identity, issuer).Verify(bytes.NewReader(bundle()), bytes.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		context("failure cases", func() {
+			it("returns an error when the file does not exist", func() {
+				_, err := components.LoadTrustRoot(filepath.Join(t.TempDir(), "missing"))
+				Expect(err).To(MatchError(ContainSubstring("failed to load trust root")))
+			})
+
+			it("returns an error when the trust root has no logs or certificate authorities", func() {
+				_, err := components.ParseTrustRoot([]byte(`{"mediaType": "application/vnd.dev.sigstore.trustedroot+json;version=0.1"}`))
+				Expect(err).To(MatchError("failed to parse trust root: at least one transparency log and certificate authority are required"))
+			})
+		})
+	})
+}
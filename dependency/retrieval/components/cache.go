@@ -0,0 +1,200 @@
+package components
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ArtifactCache stores downloaded release artifacts under their sha512 digest,
+// so that every artifact is downloaded once and verified from the same bytes.
+// The detached signature or attestation bundle that an artifact was verified
+// with and the result of the verification are stored next to it, so that later
+// runs can verify the artifact again without downloading either.
+type ArtifactCache struct {
+	dir    string
+	client *http.Client
+}
+
+func NewArtifactCache(dir string) ArtifactCache {
+	return ArtifactCache{
+		dir:    dir,
+		client: http.DefaultClient,
+	}
+}
+
+// DefaultCacheDir is the cache location used when none is given, in the
+// cache directory of the user, or in the working directory when the user has
+// none. A shared location such as the temporary directory is not used, since
+// other users could place artifacts there.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "icu-retrieval-cache"
+	}
+
+	return filepath.Join(dir, "icu-retrieval")
+}
+
+func (c ArtifactCache) path(checksum string) string {
+	return filepath.Join(c.dir, "sha512", strings.ToLower(checksum))
+}
+
+// Fetch returns the path of the artifact with the given sha512 checksum. The
+// artifact is downloaded from the URL when it is not in the cache yet, or when
+// the cached file no longer matches the checksum, and is only added to the
+// cache when the downloaded bytes match the checksum.
+func (c ArtifactCache) Fetch(uri, checksum string) (string, error) {
+	path := c.path(checksum)
+
+	digest, err := fileDigest(path)
+	if err == nil && strings.EqualFold(digest, checksum) {
+		return path, nil
+	}
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("failed to read artifact cache: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return "", fmt.Errorf("failed to create artifact cache: %w", err)
+	}
+
+	content, err := c.download(uri)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	file, err := os.CreateTemp(filepath.Dir(path), "download-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create artifact cache: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	hash := sha512.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), content)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", uri, err)
+	}
+
+	if !strings.EqualFold(hex.EncodeToString(hash.Sum(nil)), checksum) {
+		return "", fmt.Errorf("the given checksum of the source does not match with downloaded source")
+	}
+
+	err = file.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to write artifact cache: %w", err)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return "", fmt.Errorf("failed to write artifact cache: %w", err)
+	}
+
+	return path, nil
+}
+
+func fileDigest(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha512.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Download returns the contents of the URL without caching them, for small
+// files such as signatures whose digest is not known in advance.
+func (c ArtifactCache) Download(uri string) ([]byte, error) {
+	content, err := c.download(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	return io.ReadAll(content)
+}
+
+func (c ArtifactCache) download(uri string) (io.ReadCloser, error) {
+	resp, err := c.client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		resp.Body.Close()
+		return nil, fmt.Errorf("received a non 200 status code from %s: status code %d received", uri, resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// Evidence returns the signature or attestation bundle that the artifact with
+// the given checksum was verified with by an earlier run, along with the
+// result of that verification, and whether there is one. The evidence is
+// verified again with the current verifiers before it is relied on, so that
+// changes to the keyring, pinned fingerprints or trust root take effect.
+func (c ArtifactCache) Evidence(checksum string) ([]byte, VerificationResult, bool, error) {
+	evidence, err := os.ReadFile(c.path(checksum) + ".sig")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, VerificationResult{}, false, nil
+	}
+	if err != nil {
+		return nil, VerificationResult{}, false, fmt.Errorf("failed to read artifact cache: %w", err)
+	}
+
+	content, err := os.ReadFile(c.path(checksum) + ".json")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, VerificationResult{}, false, nil
+	}
+	if err != nil {
+		return nil, VerificationResult{}, false, fmt.Errorf("failed to read artifact cache: %w", err)
+	}
+
+	var result VerificationResult
+	err = json.Unmarshal(content, &result)
+	if err != nil {
+		return nil, VerificationResult{}, false, fmt.Errorf("failed to read artifact cache: %w", err)
+	}
+
+	return evidence, result, true, nil
+}
+
+// RecordVerified stores the signature or attestation bundle that the artifact
+// with the given checksum was verified with, and the result of the
+// verification.
+func (c ArtifactCache) RecordVerified(checksum string, signature []byte, result VerificationResult) error {
+	err := os.WriteFile(c.path(checksum)+".sig", signature, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write artifact cache: %w", err)
+	}
+
+	content, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to write artifact cache: %w", err)
+	}
+
+	err = os.WriteFile(c.path(checksum)+".json", content, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write artifact cache: %w", err)
+	}
+
+	return nil
+}
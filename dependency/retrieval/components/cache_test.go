@@ -0,0 +1,135 @@
+package components_test
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testCache(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		server   *httptest.Server
+		cache    components.ArtifactCache
+		cacheDir string
+		checksum string
+		requests int
+	)
+
+	it.Before(func() {
+		sum := sha512.Sum512([]byte("some-content"))
+		checksum = hex.EncodeToString(sum[:])
+		requests = 0
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requests++
+			fmt.Fprint(w, "some-content")
+		}))
+
+		cacheDir = t.TempDir()
+		cache = components.NewArtifactCache(cacheDir)
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	context("Fetch", func() {
+		it("downloads the artifact once and stores it under its digest", func() {
+			path, err := cache.Fetch(server.URL, checksum)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(cacheDir, "sha512", checksum)))
+			Expect(os.ReadFile(path)).To(Equal([]byte("some-content")))
+
+			path, err = cache.Fetch(server.URL, strings.ToUpper(checksum))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(cacheDir, "sha512", checksum)))
+
+			Expect(requests).To(Equal(1))
+		})
+
+		context("when the cached artifact no longer matches its digest", func() {
+			it.Before(func() {
+				_, err := cache.Fetch(server.URL, checksum)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.WriteFile(filepath.Join(cacheDir, "sha512", checksum), []byte("tampered-content"), 0600)).To(Succeed())
+			})
+
+			it("downloads the artifact again", func() {
+				path, err := cache.Fetch(server.URL, checksum)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(os.ReadFile(path)).To(Equal([]byte("some-content")))
+
+				Expect(requests).To(Equal(2))
+			})
+		})
+
+		context("when the checksum does not match", func() {
+			it("returns an error and does not cache the artifact", func() {
+				_, err := cache.Fetch(server.URL, "aaaaaaaaaaaa")
+				Expect(err).To(MatchError("the given checksum of the source does not match with downloaded source"))
+
+				files, err := os.ReadDir(filepath.Join(cacheDir, "sha512"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(files).To(BeEmpty())
+			})
+		})
+
+		context("when the download fails", func() {
+			it("returns an error", func() {
+				_, err := cache.Fetch("not a valid url", checksum)
+				Expect(err).To(MatchError(ContainSubstring("unsupported protocol scheme")))
+			})
+		})
+	})
+
+	context("RecordVerified", func() {
+		it("stores the evidence along with the result", func() {
+			_, _, ok, err := cache.Evidence(checksum)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			_, err = cache.Fetch(server.URL, checksum)
+			Expect(err).NotTo(HaveOccurred())
+
+			result := components.VerificationResult{
+				Fingerprint:   "some-fingerprint",
+				UserID:        "Some Signer <signer@example.com>",
+				CreationTime:  time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC),
+				HashAlgorithm: "SHA-512",
+			}
+			Expect(cache.RecordVerified(checksum, []byte("some-signature"), result)).To(Succeed())
+
+			evidence, verification, ok, err := components.NewArtifactCache(cacheDir).Evidence(checksum)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(evidence).To(Equal([]byte("some-signature")))
+			Expect(verification).To(Equal(result))
+
+			Expect(os.ReadFile(filepath.Join(cacheDir, "sha512", fmt.Sprintf("%s.sig", checksum)))).To(Equal([]byte("some-signature")))
+		})
+	})
+
+	context("DefaultCacheDir", func() {
+		it("is in the cache directory of the user", func() {
+			dir, err := os.UserCacheDir()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(components.DefaultCacheDir()).To(Equal(filepath.Join(dir, "icu-retrieval")))
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package components
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var checksumEntryPattern = regexp.MustCompile(`^([0-9a-fA-F]{128}) [ *](\S.*)$`)
+
+// ParseChecksums parses a SHASUM512.txt file in the format written by
+// sha512sum, and returns the lower case sha512 digest of every file it lists.
+// Blank lines are ignored; any other line that is not an entry, and files that
+// are listed more than once, are errors.
+func ParseChecksums(content []byte) (map[string]string, error) {
+	checksums := map[string]string{}
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		submatch := checksumEntryPattern.FindStringSubmatch(line)
+		if submatch == nil {
+			return nil, fmt.Errorf("line %d is not a sha512 checksum entry: %q", i+1, line)
+		}
+
+		if _, ok := checksums[submatch[2]]; ok {
+			return nil, fmt.Errorf("%s is listed more than once", submatch[2])
+		}
+
+		checksums[submatch[2]] = strings.ToLower(submatch[1])
+	}
+
+	if len(checksums) == 0 {
+		return nil, fmt.Errorf("no checksum entries found")
+	}
+
+	return checksums, nil
+}
+
+// CheckDigest compares the content of a release file with the digest that the
+// release reports for it, in the "<algorithm>:<hex>" form of the GitHub
+// release asset API. Files without a digest are not checked.
+func CheckDigest(file ReleaseFile, content io.Reader) error {
+	if file.Digest == "" {
+		return nil
+	}
+
+	algorithm, expected, _ := strings.Cut(file.Digest, ":")
+
+	var h hash.Hash
+	switch strings.ToLower(algorithm) {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported digest %q reported for %s", file.Digest, file.Name)
+	}
+
+	_, err := io.Copy(h, content)
+	if err != nil {
+		return fmt.Errorf("failed to check the digest of %s: %w", file.Name, err)
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("the digest of %s does not match the digest reported by the release: got %s:%s, expected %s", file.Name, strings.ToLower(algorithm), actual, file.Digest)
+	}
+
+	return nil
+}
+
+// compareChecksums fails when the checksum file and the release disagree on
+// the sha512 digest of a file. Digests in other algorithms cannot be compared
+// without downloading the file, and are checked with CheckDigest instead.
+func compareChecksums(checksumFile string, checksums map[string]string, files []ReleaseFile) error {
+	for _, file := range files {
+		algorithm, digest, _ := strings.Cut(file.Digest, ":")
+		if !strings.EqualFold(algorithm, "sha512") {
+			continue
+		}
+
+		checksum, ok := checksums[file.Name]
+		if !ok {
+			continue
+		}
+
+		if !strings.EqualFold(checksum, digest) {
+			return fmt.Errorf("%s lists a digest for %s that does not match the digest reported by the release: got sha512:%s, expected %s", checksumFile, file.Name, checksum, file.Digest)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package components_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testChecksums(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		digest = strings.Repeat("ab", 64)
+	)
+
+	context("ParseChecksums", func() {
+		it("returns every entry of the file", func() {
+			checksums, err := components.ParseChecksums([]byte(fmt.Sprintf("%s  icu4c-74_2-src.tgz\r\n\n%s *icu4c-74_2-src.zip\n", strings.ToUpper(digest), digest)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checksums).To(Equal(map[string]string{
+				"icu4c-74_2-src.tgz": digest,
+				"icu4c-74_2-src.zip": digest,
+			}))
+		})
+
+		context("failure cases", func() {
+			for _, example := range []struct {
+				name    string
+				content string
+				err     string
+			}{
+				{name: "a digest that is not sha512", content: "aaaaaaaaaaaa  icu4c-74_2-src.tgz\n", err: `line 1 is not a sha512 checksum entry: "aaaaaaaaaaaa  icu4c-74_2-src.tgz"`},
+				{name: "an entry without a file", content: digest + "  \n", err: fmt.Sprintf("line 1 is not a sha512 checksum entry: %q", digest+"  ")},
+				{name: "text around the entries", content: "SHA512 checksums\n" + digest + "  icu4c-74_2-src.tgz\n", err: `line 1 is not a sha512 checksum entry: "SHA512 checksums"`},
+				{name: "a file listed twice", content: digest + "  icu4c-74_2-src.tgz\n" + digest + "  icu4c-74_2-src.tgz\n", err: "icu4c-74_2-src.tgz is listed more than once"},
+				{name: "no entries", content: "\n", err: "no checksum entries found"},
+			} {
+				example := example
+
+				it(fmt.Sprintf("returns an error for %s", example.name), func() {
+					_, err := components.ParseChecksums([]byte(example.content))
+					Expect(err).To(MatchError(example.err))
+				})
+			}
+		})
+	})
+
+	context("CheckDigest", func() {
+		var sum [32]byte
+
+		it.Before(func() {
+			sum = sha256.Sum256([]byte("some-content"))
+		})
+
+		it("accepts content that matches the digest", func() {
+			file := components.ReleaseFile{Name: "some-file", Digest: fmt.Sprintf("sha256:%X", sum)}
+			Expect(components.CheckDigest(file, bytes.NewReader([]byte("some-content")))).To(Succeed())
+		})
+
+		it("does not check files without a digest", func() {
+			Expect(components.CheckDigest(components.ReleaseFile{Name: "some-file"}, iotest.ErrReader(fmt.Errorf("failed to read")))).To(Succeed())
+		})
+
+		context("failure cases", func() {
+			it("returns an error when the content does not match", func() {
+				file := components.ReleaseFile{Name: "some-file", Digest: fmt.Sprintf("sha256:%x", sum)}
+				err := components.CheckDigest(file, bytes.NewReader([]byte("other-content")))
+				Expect(err).To(MatchError(ContainSubstring("the digest of some-file does not match the digest reported by the release: got sha256:")))
+			})
+
+			it("returns an error when the algorithm is not supported", func() {
+				file := components.ReleaseFile{Name: "some-file", Digest: "md5:abc"}
+				err := components.CheckDigest(file, bytes.NewReader([]byte("some-content")))
+				Expect(err).To(MatchError(`unsupported digest "md5:abc" reported for some-file`))
+			})
+
+			it("returns an error when the content cannot be read", func() {
+				file := components.ReleaseFile{Name: "some-file", Digest: fmt.Sprintf("sha256:%x", sum)}
+				err := components.CheckDigest(file, iotest.ErrReader(fmt.Errorf("failed to read")))
+				Expect(err).To(MatchError("failed to check the digest of some-file: failed to read"))
+			})
+		})
+	})
+}
@@ -1,11 +1,14 @@
 package components
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
-	"regexp"
+	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/paketo-buildpacks/libdependency/collections"
 	"github.com/paketo-buildpacks/libdependency/retrieve"
@@ -15,15 +18,26 @@ import (
 
 //go:generate faux --interface SignatureVerifier --output fakes/signature_verifier.go
 type SignatureVerifier interface {
-	Verify(signatureURL, targetURL string) error
+	Verify(signature io.Reader, target io.ReadSeeker) (VerificationResult, error)
 }
 
 type IcuReleaseFiles struct {
-	Source    ReleaseFile
-	Signature ReleaseFile
-	Shasum512 ReleaseFile
+	Source             ReleaseFile
+	Signature          ReleaseFile
+	Attestation        ReleaseFile
+	Shasum512          ReleaseFile
+	Shasum512Signature ReleaseFile
 }
 
+// The verification policies, which choose whether the source of a release
+// must be verified with its PGP signature, with a Sigstore bundle holding its
+// provenance attestation, or with either of them.
+const (
+	PolicyPGP         = "pgp"
+	PolicyAttestation = "attestation"
+	PolicyEither      = "either"
+)
+
 type StackAndTargetPair struct {
 	stacks []string
 	target string
@@ -50,14 +64,39 @@ type PlatformStackTarget struct {
 }
 
 type Generator struct {
-	SignatureVerifier SignatureVerifier
-	Targets           []PlatformStackTarget
+	SignatureVerifier   SignatureVerifier
+	AttestationVerifier SignatureVerifier
+	Policy              string
+	Targets             []PlatformStackTarget
+	Format              string
+	Cache               ArtifactCache
+
+	verifications *verifications
+}
+
+// verifications are the results of verifying the sources of every version,
+// shared by the copies of a Generator.
+type verifications struct {
+	mutex     sync.Mutex
+	byVersion map[string]verification
+}
+
+// verification is how the source of a version was verified, and the release
+// files that it was verified with.
+type verification struct {
+	result    VerificationResult
+	files     IcuReleaseFiles
+	checksums map[string]string
 }
 
 func NewGenerator() Generator {
 	return Generator{
 		SignatureVerifier: NewVerifier(),
+		Policy:            PolicyPGP,
 		Targets:           getSupportedPlatformStackTargets(),
+		Format:            FormatGzip,
+		Cache:             NewArtifactCache(DefaultCacheDir()),
+		verifications:     &verifications{byVersion: map[string]verification{}},
 	}
 }
 
@@ -66,11 +105,37 @@ func (g Generator) WithVerifier(signatureVerifier SignatureVerifier) Generator {
 	return g
 }
 
+// WithAttestationVerifier sets the verifier of the Sigstore bundles that
+// releases publish next to their source.
+func (g Generator) WithAttestationVerifier(attestationVerifier SignatureVerifier) Generator {
+	g.AttestationVerifier = attestationVerifier
+	return g
+}
+
+// WithPolicy sets the verification policy, one of PolicyPGP, PolicyAttestation
+// or PolicyEither.
+func (g Generator) WithPolicy(policy string) Generator {
+	g.Policy = policy
+	return g
+}
+
 func (g Generator) WithTarget(target PlatformStackTarget) Generator {
 	g.Targets = []PlatformStackTarget{target}
 	return g
 }
 
+func (g Generator) WithFormat(format string) Generator {
+	g.Format = format
+	return g
+}
+
+// WithCacheDir sets the directory that release artifacts are downloaded to and
+// verified from.
+func (g Generator) WithCacheDir(dir string) Generator {
+	g.Cache = NewArtifactCache(dir)
+	return g
+}
+
 func getSupportedPlatformStackTargets() []PlatformStackTarget {
 	var platformStackTargets []PlatformStackTarget
 
@@ -99,15 +164,36 @@ func (g Generator) GenerateMetadata(versionFetcher versionology.VersionFetcher)
 		return nil, err
 	}
 
-	checksum, err := getChecksum(icuUrls.Shasum512.URL, icuUrls.Source.Name)
+	methods, err := g.verificationMethods(icuUrls)
 	if err != nil {
 		return nil, err
 	}
-	err = verifySources(icuUrls, checksum, g.SignatureVerifier)
+
+	checksums, err := g.getChecksums(icuUrls, icuVersion.Files)
 	if err != nil {
 		return nil, err
 	}
 
+	checksum, ok := checksums[icuUrls.Source.Name]
+	if !ok {
+		return nil, fmt.Errorf("%s has no entry for %s", icuUrls.Shasum512.Name, icuUrls.Source.Name)
+	}
+
+	result, err := g.verifySources(icuUrls, checksum, methods)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.verifications != nil {
+		g.verifications.mutex.Lock()
+		g.verifications.byVersion[version] = verification{
+			result:    result,
+			files:     icuUrls,
+			checksums: checksums,
+		}
+		g.verifications.mutex.Unlock()
+	}
+
 	cpe := fmt.Sprintf(`cpe:2.3:a:icu-project:international_components_for_unicode:%s:*:*:*:*:c\/c\+\+:*:*`, icuVersion.ReleaseVersion)
 	purl := retrieve.GeneratePURL("icu", icuVersion.ReleaseVersion, checksum, icuUrls.Source.URL)
 
@@ -132,7 +218,7 @@ func (g Generator) GenerateMetadata(versionFetcher versionology.VersionFetcher)
 }
 
 func getReleaseFiles(release IcuRelease) (IcuReleaseFiles, error) {
-	var source, shasum512, asc ReleaseFile
+	var source, shasum512, shasum512Asc, asc, bundle ReleaseFile
 	for _, f := range release.Files {
 		if f.Name == fmt.Sprintf("icu4c-%s-src.tgz", strings.ReplaceAll(release.ReleaseVersion, ".", "_")) {
 			source = f
@@ -151,65 +237,246 @@ func getReleaseFiles(release IcuRelease) (IcuReleaseFiles, error) {
 		if f.Name == "SHASUM512.txt" {
 			shasum512 = f
 		}
+		if f.Name == "SHASUM512.txt.asc" {
+			shasum512Asc = f
+		}
 	}
 
-	if (source == ReleaseFile{} || shasum512 == ReleaseFile{} || asc == ReleaseFile{}) {
+	if (source == ReleaseFile{} || shasum512 == ReleaseFile{}) {
 		return IcuReleaseFiles{}, fmt.Errorf("required files are missing from the release")
 	}
 
+	// Sigstore bundles are named after the artifact that they attest.
+	for _, f := range release.Files {
+		if f.Name == source.Name+".sigstore.json" || f.Name == source.Name+".sigstore" {
+			bundle = f
+		}
+	}
+
 	return IcuReleaseFiles{
-		Source:    source,
-		Signature: asc,
-		Shasum512: shasum512,
+		Source:             source,
+		Signature:          asc,
+		Attestation:        bundle,
+		Shasum512:          shasum512,
+		Shasum512Signature: shasum512Asc,
 	}, nil
 }
 
-func getChecksum(checksumUrl string, fileName string) (string, error) {
-	shasumResponse, err := http.Get(checksumUrl)
+// getChecksums downloads and parses the checksum file of the release. The
+// checksum file is verified with its detached signature when the release has
+// one, and must agree with every digest that the release reports.
+func (g Generator) getChecksums(files IcuReleaseFiles, releaseFiles []ReleaseFile) (map[string]string, error) {
+	content, err := g.Cache.Download(files.Shasum512.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckDigest(files.Shasum512, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	if files.Shasum512Signature != (ReleaseFile{}) {
+		signature, err := g.Cache.Download(files.Shasum512Signature.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		err = CheckDigest(files.Shasum512Signature, bytes.NewReader(signature))
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = g.SignatureVerifier.Verify(bytes.NewReader(signature), bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify %s: %w", files.Shasum512.Name, err)
+		}
+	}
+
+	checksums, err := ParseChecksums(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", files.Shasum512.Name, err)
+	}
+
+	err = compareChecksums(files.Shasum512.Name, checksums, releaseFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return checksums, nil
+}
+
+// Verification returns the result of verifying the source of the given
+// version, once metadata has been generated for it.
+func (g Generator) Verification(version string) (VerificationResult, bool) {
+	if g.verifications == nil {
+		return VerificationResult{}, false
+	}
+
+	g.verifications.mutex.Lock()
+	defer g.verifications.mutex.Unlock()
+
+	verification, ok := g.verifications.byVersion[version]
+	return verification.result, ok
+}
+
+type verificationMethod struct {
+	name     string
+	evidence ReleaseFile
+	verifier SignatureVerifier
+}
+
+// verificationMethods returns the ways that the source of the release can be
+// verified under the policy of the generator, in the order they are tried.
+func (g Generator) verificationMethods(files IcuReleaseFiles) ([]verificationMethod, error) {
+	pgp := verificationMethod{name: PolicyPGP, evidence: files.Signature, verifier: g.SignatureVerifier}
+	attestation := verificationMethod{name: PolicyAttestation, evidence: files.Attestation, verifier: g.AttestationVerifier}
+
+	switch g.Policy {
+	case PolicyPGP:
+		if files.Signature == (ReleaseFile{}) {
+			return nil, fmt.Errorf("required files are missing from the release")
+		}
+
+		return []verificationMethod{pgp}, nil
+
+	case PolicyAttestation:
+		if g.AttestationVerifier == nil {
+			return nil, fmt.Errorf("an attestation verifier is required for the %q policy", g.Policy)
+		}
+
+		if files.Attestation == (ReleaseFile{}) {
+			return nil, fmt.Errorf("required files are missing from the release: no attestation bundle for %s", files.Source.Name)
+		}
+
+		return []verificationMethod{attestation}, nil
+
+	case PolicyEither:
+		var methods []verificationMethod
+		if files.Signature != (ReleaseFile{}) {
+			methods = append(methods, pgp)
+		}
+
+		if files.Attestation != (ReleaseFile{}) && g.AttestationVerifier != nil {
+			methods = append(methods, attestation)
+		}
+
+		if len(methods) == 0 {
+			return nil, fmt.Errorf("required files are missing from the release: no signature or attestation bundle for %s", files.Source.Name)
+		}
+
+		return methods, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported verification policy %q: must be one of %q, %q or %q", g.Policy, PolicyPGP, PolicyAttestation, PolicyEither)
+	}
+}
+
+// verifySources downloads the source into the cache, which checks it against
+// the checksum, checks it against the digest that the release reports, and
+// verifies it from the cached bytes with the first of the methods that
+// succeeds. Sources that were verified by an earlier run with one of the
+// methods are verified again with the evidence stored by that run, so that
+// the signature or bundle is not downloaded again but the current keyring,
+// pinned fingerprints and trust root still apply.
+func (g Generator) verifySources(files IcuReleaseFiles, checksum string, methods []verificationMethod) (VerificationResult, error) {
+	path, err := g.Cache.Fetch(files.Source.URL, checksum)
 	if err != nil {
-		return "", err
+		return VerificationResult{}, err
 	}
-	defer shasumResponse.Body.Close()
 
-	b, err := io.ReadAll(shasumResponse.Body)
+	source, err := os.Open(path)
 	if err != nil {
-		return "", err
+		return VerificationResult{}, fmt.Errorf("failed to open cached source: %w", err)
+	}
+	defer source.Close()
+
+	err = CheckDigest(files.Source, source)
+	if err != nil {
+		return VerificationResult{}, err
+	}
+
+	evidence, recorded, ok, err := g.Cache.Evidence(checksum)
+	if err != nil {
+		return VerificationResult{}, err
+	}
+
+	if ok {
+		// Results recorded before verification methods were recorded
+		// come from PGP signatures.
+		name := recorded.Method
+		if name == "" {
+			name = PolicyPGP
+		}
+
+		// Results recorded before verification times were recorded are
+		// dated by this run.
+		verifiedAt := recorded.VerifiedAt
+		if verifiedAt.IsZero() {
+			verifiedAt = time.Now().UTC()
+		}
+
+		for _, method := range methods {
+			if method.name != name {
+				continue
+			}
+
+			// When the stored evidence no longer verifies, every method is
+			// tried below as it is for a source that was never verified.
+			result, err := g.verifyEvidence(source, checksum, method, evidence, verifiedAt)
+			if err == nil {
+				return result, nil
+			}
+		}
 	}
 
-	r := regexp.MustCompile(fmt.Sprintf(`([0-9a-fA-F]+)[\s\*]+%s`, fileName))
+	var errs []error
+	for _, method := range methods {
+		result, err := g.verifySource(source, checksum, method)
+		if err == nil {
+			return result, nil
+		}
 
-	submatch := r.FindStringSubmatch(string(b))
-	if len(submatch) == 0 {
-		return "", fmt.Errorf("unable to parse the SHASUM512 file")
+		errs = append(errs, err)
 	}
-	checksum := submatch[1]
 
-	return checksum, nil
+	return VerificationResult{}, errors.Join(errs...)
 }
 
-func verifySources(files IcuReleaseFiles, checksum string, signatureVerifier SignatureVerifier) error {
-	// Verify the checksum
-	response, err := http.Get(files.Source.URL)
+func (g Generator) verifySource(source io.ReadSeeker, checksum string, method verificationMethod) (VerificationResult, error) {
+	evidence, err := g.Cache.Download(method.evidence.URL)
 	if err != nil {
-		return err
+		return VerificationResult{}, err
 	}
-	defer response.Body.Close()
 
-	vr := cargo.NewValidatedReader(response.Body, fmt.Sprintf("sha512:%s", checksum))
-	valid, err := vr.Valid()
+	return g.verifyEvidence(source, checksum, method, evidence, time.Now().UTC())
+}
+
+// verifyEvidence verifies the source with the signature or attestation bundle
+// of the method, and records the evidence and the result, dated with the given
+// verification time, in the cache.
+func (g Generator) verifyEvidence(source io.ReadSeeker, checksum string, method verificationMethod, evidence []byte, verifiedAt time.Time) (VerificationResult, error) {
+	err := CheckDigest(method.evidence, bytes.NewReader(evidence))
+	if err != nil {
+		return VerificationResult{}, err
+	}
+
+	_, err = source.Seek(0, io.SeekStart)
 	if err != nil {
-		return err
+		return VerificationResult{}, fmt.Errorf("failed to open cached source: %w", err)
 	}
 
-	if !valid {
-		return fmt.Errorf("the given checksum of the source does not match with downloaded source")
+	result, err := method.verifier.Verify(bytes.NewReader(evidence), source)
+	if err != nil {
+		return VerificationResult{}, err
 	}
+	result.Method = method.name
+	result.VerifiedAt = verifiedAt
 
-	// Verify the signature
-	err = signatureVerifier.Verify(files.Signature.URL, files.Source.URL)
+	err = g.Cache.RecordVerified(checksum, evidence, result)
 	if err != nil {
-		return err
+		return VerificationResult{}, err
 	}
 
-	return nil
+	return result, nil
 }
@@ -4,10 +4,21 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
@@ -57,10 +68,20 @@ func testDependency(t *testing.T, context spec.G, it spec.S) {
 			server            *httptest.Server
 			signatureVerifier *fakes.SignatureVerifier
 			generator         components.Generator
+			buffer            *bytes.Buffer
+			release           components.IcuRelease
+			checksum          string
+			shasums           string
+			cacheDir          string
+
+			mutex             sync.Mutex
+			sourceRequests    int
+			signatureRequests int
+			verifiedContent   []string
 		)
 
 		it.Before(func() {
-			buffer := bytes.NewBuffer(nil)
+			buffer = bytes.NewBuffer(nil)
 			gw := gzip.NewWriter(buffer)
 			tw := tar.NewWriter(gw)
 
@@ -76,6 +97,17 @@ func testDependency(t *testing.T, context spec.G, it spec.S) {
 			Expect(tw.Close()).To(Succeed())
 			Expect(gw.Close()).To(Succeed())
 
+			sum := sha512.Sum512(buffer.Bytes())
+			checksum = hex.EncodeToString(sum[:])
+
+			shasums = fmt.Sprintf(`d4bb1baed99674074f8af024dd159898eddaf4d71bc90f8d95b8448e96aac4b4e8358f755a516bfaf84baa34bf8657dc994459ef3bd72f54496b9ce2b0bd4636  icu4c-72_1-src.zip
+%s  icu4c-72_1-src.tgz
+`, checksum)
+
+			sourceRequests = 0
+			signatureRequests = 0
+			verifiedContent = nil
+
 			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 				if req.Method == http.MethodHead {
 					http.Error(w, "NotFound", http.StatusNotFound)
@@ -84,14 +116,40 @@ func testDependency(t *testing.T, context spec.G, it spec.S) {
 
 				switch req.URL.Path {
 				case "/source":
+					mutex.Lock()
+					sourceRequests++
+					mutex.Unlock()
+
 					w.WriteHeader(http.StatusOK)
 					_, err := w.Write(buffer.Bytes())
 					Expect(err).NotTo(HaveOccurred())
 
+				case "/source-asc":
+					mutex.Lock()
+					signatureRequests++
+					mutex.Unlock()
+
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprint(w, "some-signature")
+
+				case "/source-bundle":
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprint(w, "some-bundle")
+
+				case "/non-200":
+					w.WriteHeader(http.StatusTeapot)
+
 				case "/shasum512":
 					w.WriteHeader(http.StatusOK)
-					fmt.Fprintln(w, `d4bb1baed99674074f8af024dd159898eddaf4d71bc90f8d95b8448e96aac4b4e8358f755a516bfaf84baa34bf8657dc994459ef3bd72f54496b9ce2b0bd4636  icu4c-72_1-src.zip
-a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb6378ace3f97402ebfb91beb815c18fea5c8136c3a9a04eff66c  icu4c-72_1-src.tgz`)
+					fmt.Fprint(w, shasums)
+
+				case "/shasum512-asc":
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprint(w, "shasum-signature")
+
+				case "/malformed-shasum":
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprintf(w, "%s  icu4c-72_1-src.tgz\nsome-text\n", checksum)
 
 				case "/bad-shasum":
 					w.WriteHeader(http.StatusOK)
@@ -99,7 +157,7 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 
 				case "/wrong-shasum":
 					w.WriteHeader(http.StatusOK)
-					fmt.Fprintln(w, `aaaaaaaaaaaa  icu4c-72_1-src.tgz`)
+					fmt.Fprintf(w, "%s  icu4c-72_1-src.tgz\n", strings.Repeat("a", 128))
 
 				default:
 					t.Fatalf("unknown path: %s", req.URL.Path)
@@ -107,9 +165,47 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 			}))
 
 			signatureVerifier = &fakes.SignatureVerifier{}
+			signatureVerifier.VerifyCall.Returns.VerificationResult = components.VerificationResult{
+				Fingerprint:   "some-fingerprint",
+				UserID:        "Some Signer <signer@example.com>",
+				CreationTime:  time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC),
+				HashAlgorithm: "SHA-512",
+			}
+			signatureVerifier.VerifyCall.Stub = func(signature io.Reader, target io.ReadSeeker) (components.VerificationResult, error) {
+				signatureContent, err := io.ReadAll(signature)
+				Expect(err).NotTo(HaveOccurred())
+
+				targetContent, err := io.ReadAll(target)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedContent = []string{string(signatureContent), string(targetContent)}
+				return signatureVerifier.VerifyCall.Returns.VerificationResult, signatureVerifier.VerifyCall.Returns.Error
+			}
+
+			release = components.IcuRelease{
+				SemVer:         semver.MustParse("72.1"),
+				ReleaseVersion: "72.1",
+				Files: []components.ReleaseFile{
+					{
+						Name: "icu4c-72_1-src.tgz",
+						URL:  fmt.Sprintf("%s/source", server.URL),
+					},
+					{
+						Name: "icu4c-72_1-src.tgz.asc",
+						URL:  fmt.Sprintf("%s/source-asc", server.URL),
+					},
+					{
+						Name: "SHASUM512.txt",
+						URL:  fmt.Sprintf("%s/shasum512", server.URL),
+					},
+				},
+			}
+
+			cacheDir = t.TempDir()
 			generator = components.
 				NewGenerator().
 				WithVerifier(signatureVerifier).
+				WithCacheDir(cacheDir).
 				WithTarget(components.PlatformStackTarget{
 					Stacks: []string{"stack"},
 					OS:     "linux",
@@ -147,13 +243,13 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 					ConfigMetadataDependency: cargo.ConfigMetadataDependency{
 						Checksum:        "",
 						CPE:             "cpe:2.3:a:icu-project:international_components_for_unicode:72.1:*:*:*:*:c\\/c\\+\\+:*:*",
-						PURL:            fmt.Sprintf("pkg:generic/icu@72.1?checksum=a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb6378ace3f97402ebfb91beb815c18fea5c8136c3a9a04eff66c&download_url=%s/source", server.URL),
+						PURL:            fmt.Sprintf("pkg:generic/icu@72.1?checksum=%s&download_url=%s/source", checksum, server.URL),
 						ID:              "icu",
 						Licenses:        []interface{}{"BSD-2-Clause", "BSD-3-Clause", "ICU", "Unicode-TOU"},
 						Name:            "ICU",
 						SHA256:          "",
 						Source:          fmt.Sprintf("%s/source", server.URL),
-						SourceChecksum:  "sha512:a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb6378ace3f97402ebfb91beb815c18fea5c8136c3a9a04eff66c",
+						SourceChecksum:  fmt.Sprintf("sha512:%s", checksum),
 						SourceSHA256:    "",
 						StripComponents: 0,
 						URI:             "",
@@ -166,11 +262,460 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 					Target:        "target",
 				}))
 
-			Expect(signatureVerifier.VerifyCall.Receives.SignatureURL).To(Equal(fmt.Sprintf("%s/source-asc", server.URL)))
-			Expect(signatureVerifier.VerifyCall.Receives.TargetURL).To(Equal(fmt.Sprintf("%s/source", server.URL)))
+			Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(1))
+			Expect(verifiedContent).To(Equal([]string{"some-signature", buffer.String()}))
+			Expect(sourceRequests).To(Equal(1))
+
+			Expect(filepath.Join(cacheDir, "sha512", checksum)).To(BeARegularFile())
+			Expect(os.ReadFile(filepath.Join(cacheDir, "sha512", fmt.Sprintf("%s.sig", checksum)))).To(Equal([]byte("some-signature")))
+
+			artifacts, err := generator.RecordArtifacts(dependencies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(HaveLen(1))
+			Expect(artifacts[0].Signature.VerifiedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			artifacts[0].Signature.VerifiedAt = time.Time{}
+			Expect(artifacts[0].Signature).To(Equal(&components.VerificationResult{
+				Method:        "pgp",
+				Fingerprint:   "some-fingerprint",
+				UserID:        "Some Signer <signer@example.com>",
+				CreationTime:  time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC),
+				HashAlgorithm: "SHA-512",
+			}))
+		})
+
+		context("when the source has been verified by an earlier run", func() {
+			var verifiedAt time.Time
+
+			it.Before(func() {
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				result, ok := generator.Verification("72.1")
+				Expect(ok).To(BeTrue())
+				verifiedAt = result.VerifiedAt
+			})
+
+			it("verifies the cached source again with the stored signature", func() {
+				generator = components.NewGenerator().WithVerifier(signatureVerifier).WithCacheDir(cacheDir)
+
+				dependencies, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(sourceRequests).To(Equal(1))
+				Expect(signatureRequests).To(Equal(1))
+				Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(2))
+				Expect(verifiedContent).To(Equal([]string{"some-signature", buffer.String()}))
+
+				artifacts, err := generator.RecordArtifacts(dependencies)
+				Expect(err).NotTo(HaveOccurred())
+				for _, artifact := range artifacts {
+					Expect(artifact.Signature).NotTo(BeNil())
+					Expect(artifact.Signature.UserID).To(Equal("Some Signer <signer@example.com>"))
+				}
+			})
+
+			it("keeps the time that the earlier run verified the source at", func() {
+				generator = components.NewGenerator().WithVerifier(signatureVerifier).WithCacheDir(cacheDir)
+
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				statement, ok := generator.Provenance("72.1")
+				Expect(ok).To(BeTrue())
+				Expect(statement.Predicate.RunDetails.Metadata.FinishedOn).To(BeTemporally("==", verifiedAt))
+			})
+
+			context("when the stored signature no longer verifies", func() {
+				it.Before(func() {
+					signatureVerifier.VerifyCall.Returns.Error = errors.New("the key is revoked")
+				})
+
+				it("returns an error", func() {
+					generator = components.NewGenerator().WithVerifier(signatureVerifier).WithCacheDir(cacheDir)
+
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError("the key is revoked"))
+				})
+			})
+		})
+
+		context("Provenance", func() {
+			var shasumsDigest string
+
+			it.Before(func() {
+				shasumsSum := sha256.Sum256([]byte(shasums))
+				shasumsDigest = hex.EncodeToString(shasumsSum[:])
+				release.Files[2].Digest = fmt.Sprintf("sha256:%s", shasumsDigest)
+				release.Files = append(release.Files, components.ReleaseFile{
+					Name: "SHASUM512.txt.asc",
+					URL:  fmt.Sprintf("%s/shasum512-asc", server.URL),
+				})
+			})
+
+			it("returns how the source of the version was retrieved and verified", func() {
+				_, ok := generator.Provenance("72.1")
+				Expect(ok).To(BeFalse())
+
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				statement, ok := generator.Provenance("72.1")
+				Expect(ok).To(BeTrue())
+				finishedOn := statement.Predicate.RunDetails.Metadata.FinishedOn
+				Expect(finishedOn).To(BeTemporally("~", time.Now(), time.Minute))
+				statement.Predicate.RunDetails.Metadata.FinishedOn = time.Time{}
+
+				source := components.ResourceDescriptor{
+					Name:   "icu4c-72_1-src.tgz",
+					URI:    fmt.Sprintf("%s/source", server.URL),
+					Digest: map[string]string{"sha512": checksum},
+				}
+				Expect(statement).To(Equal(components.ProvenanceStatement{
+					Type:          "https://in-toto.io/Statement/v1",
+					Subject:       []components.ResourceDescriptor{source},
+					PredicateType: "https://slsa.dev/provenance/v1",
+					Predicate: components.ProvenancePredicate{
+						BuildDefinition: components.ProvenanceBuildDefinition{
+							BuildType: "https://github.com/paketo-buildpacks/icu/dependency/retrieval@v1",
+							ExternalParameters: components.ProvenanceParameters{
+								Version: "72.1",
+								Policy:  "pgp",
+							},
+							ResolvedDependencies: []components.ResourceDescriptor{
+								source,
+								{
+									Name: "icu4c-72_1-src.tgz.asc",
+									URI:  fmt.Sprintf("%s/source-asc", server.URL),
+									Annotations: &components.VerificationResult{
+										Method:        "pgp",
+										Fingerprint:   "some-fingerprint",
+										UserID:        "Some Signer <signer@example.com>",
+										CreationTime:  time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC),
+										HashAlgorithm: "SHA-512",
+										VerifiedAt:    finishedOn,
+									},
+								},
+								{
+									Name:   "SHASUM512.txt",
+									URI:    fmt.Sprintf("%s/shasum512", server.URL),
+									Digest: map[string]string{"sha256": shasumsDigest},
+								},
+								{
+									Name: "SHASUM512.txt.asc",
+									URI:  fmt.Sprintf("%s/shasum512-asc", server.URL),
+								},
+							},
+						},
+						RunDetails: components.ProvenanceRunDetails{
+							Builder: components.ProvenanceBuilder{ID: "https://github.com/paketo-buildpacks/icu/dependency/retrieval"},
+						},
+					},
+				}))
+			})
+
+			it("writes the provenance statement of every version", func() {
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				dir := filepath.Join(t.TempDir(), "provenance")
+				paths, err := generator.WriteProvenance(dir)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paths).To(Equal([]string{filepath.Join(dir, "icu-72.1.intoto.json")}))
+
+				content, err := os.ReadFile(paths[0])
+				Expect(err).NotTo(HaveOccurred())
+
+				var statement map[string]interface{}
+				Expect(json.Unmarshal(content, &statement)).To(Succeed())
+				Expect(statement).To(HaveKeyWithValue("_type", "https://in-toto.io/Statement/v1"))
+				Expect(statement).To(HaveKeyWithValue("subject", []interface{}{
+					map[string]interface{}{
+						"name":   "icu4c-72_1-src.tgz",
+						"uri":    fmt.Sprintf("%s/source", server.URL),
+						"digest": map[string]interface{}{"sha512": checksum},
+					},
+				}))
+				Expect(statement).To(HaveKeyWithValue("predicateType", "https://slsa.dev/provenance/v1"))
+				Expect(statement).To(HaveKeyWithValue("predicate", HaveKeyWithValue("runDetails", HaveKeyWithValue("metadata", HaveKey("finishedOn")))))
+			})
+
+			context("when the provenance directory cannot be created", func() {
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).NotTo(HaveOccurred())
+
+					file := filepath.Join(t.TempDir(), "file")
+					Expect(os.WriteFile(file, nil, 0600)).To(Succeed())
+
+					_, err = generator.WriteProvenance(filepath.Join(file, "provenance"))
+					Expect(err).To(MatchError(ContainSubstring("failed to create provenance directory")))
+				})
+			})
+		})
+
+		context("when the shasum file is signed", func() {
+			it.Before(func() {
+				release.Files = append(release.Files, components.ReleaseFile{
+					Name: "SHASUM512.txt.asc",
+					URL:  fmt.Sprintf("%s/shasum512-asc", server.URL),
+				})
+			})
+
+			it("verifies the shasum file with its signature", func() {
+				var verified [][]string
+				stub := signatureVerifier.VerifyCall.Stub
+				signatureVerifier.VerifyCall.Stub = func(signature io.Reader, target io.ReadSeeker) (components.VerificationResult, error) {
+					result, err := stub(signature, target)
+					verified = append(verified, verifiedContent)
+					return result, err
+				}
+
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(2))
+				Expect(verified).To(Equal([][]string{
+					{"shasum-signature", shasums},
+					{"some-signature", buffer.String()},
+				}))
+			})
+
+			context("when the signature of the shasum file does not verify", func() {
+				it.Before(func() {
+					signatureVerifier.VerifyCall.Returns.Error = fmt.Errorf("verifier failed")
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError("failed to verify SHASUM512.txt: verifier failed"))
+					Expect(sourceRequests).To(Equal(0))
+				})
+			})
+		})
+
+		context("when the release reports the digests of its files", func() {
+			it.Before(func() {
+				sourceSum := sha256.Sum256(buffer.Bytes())
+				signatureSum := sha256.Sum256([]byte("some-signature"))
+				shasumsSum := sha512.Sum512([]byte(shasums))
+
+				release.Files[0].Digest = fmt.Sprintf("sha256:%x", sourceSum)
+				release.Files[1].Digest = fmt.Sprintf("sha256:%x", signatureSum)
+				release.Files[2].Digest = fmt.Sprintf("sha512:%x", shasumsSum)
+			})
+
+			it("checks the files against the digests", func() {
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			context("when the digest of the source does not match", func() {
+				it.Before(func() {
+					release.Files[0].Digest = fmt.Sprintf("sha256:%s", strings.Repeat("a", 64))
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("the digest of icu4c-72_1-src.tgz does not match the digest reported by the release: got sha256:%x, expected sha256:%s", sha256.Sum256(buffer.Bytes()), strings.Repeat("a", 64)))))
+					Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(0))
+				})
+			})
+
+			context("when the shasum file disagrees with the digest of the source", func() {
+				it.Before(func() {
+					release.Files[0].Digest = fmt.Sprintf("sha512:%s", strings.Repeat("a", 128))
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(fmt.Sprintf("SHASUM512.txt lists a digest for icu4c-72_1-src.tgz that does not match the digest reported by the release: got sha512:%s, expected sha512:%s", checksum, strings.Repeat("a", 128))))
+					Expect(sourceRequests).To(Equal(0))
+				})
+			})
+
+			context("when the digest of the shasum file does not match", func() {
+				it.Before(func() {
+					release.Files[2].Digest = fmt.Sprintf("sha512:%s", strings.Repeat("a", 128))
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(ContainSubstring("the digest of SHASUM512.txt does not match the digest reported by the release")))
+				})
+			})
+
+			context("when the digest of the signature does not match", func() {
+				it.Before(func() {
+					release.Files[1].Digest = fmt.Sprintf("sha256:%s", strings.Repeat("a", 64))
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(ContainSubstring("the digest of icu4c-72_1-src.tgz.asc does not match the digest reported by the release")))
+					Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		context("when the policy requires an attestation", func() {
+			var attestationVerifier *fakes.SignatureVerifier
+
+			it.Before(func() {
+				attestationVerifier = &fakes.SignatureVerifier{}
+				attestationVerifier.VerifyCall.Returns.VerificationResult = components.VerificationResult{
+					Fingerprint:   "some-certificate-fingerprint",
+					UserID:        "https://github.com/unicode-org/icu/.github/workflows/release.yml@refs/tags/release-72-1",
+					CreationTime:  time.Date(2025, 3, 12, 11, 0, 0, 0, time.UTC),
+					HashAlgorithm: "SHA-256",
+					Issuer:        "https://token.actions.githubusercontent.com",
+					PredicateType: "https://slsa.dev/provenance/v1",
+				}
+				attestationVerifier.VerifyCall.Stub = func(bundle io.Reader, target io.ReadSeeker) (components.VerificationResult, error) {
+					bundleContent, err := io.ReadAll(bundle)
+					Expect(err).NotTo(HaveOccurred())
+
+					targetContent, err := io.ReadAll(target)
+					Expect(err).NotTo(HaveOccurred())
+
+					verifiedContent = []string{string(bundleContent), string(targetContent)}
+					return attestationVerifier.VerifyCall.Returns.VerificationResult, attestationVerifier.VerifyCall.Returns.Error
+				}
+
+				release.Files = append(release.Files, components.ReleaseFile{
+					Name: "icu4c-72_1-src.tgz.sigstore.json",
+					URL:  fmt.Sprintf("%s/source-bundle", server.URL),
+				})
+
+				generator = generator.WithAttestationVerifier(attestationVerifier).WithPolicy(components.PolicyAttestation)
+			})
+
+			it("verifies the source with its attestation bundle", func() {
+				_, err := generator.GenerateMetadata(release)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(attestationVerifier.VerifyCall.CallCount).To(Equal(1))
+				Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(0))
+				Expect(verifiedContent).To(Equal([]string{"some-bundle", buffer.String()}))
+
+				result, ok := generator.Verification("72.1")
+				Expect(ok).To(BeTrue())
+				Expect(result.Method).To(Equal("attestation"))
+				Expect(result.PredicateType).To(Equal("https://slsa.dev/provenance/v1"))
+
+				statement, ok := generator.Provenance("72.1")
+				Expect(ok).To(BeTrue())
+				Expect(statement.Predicate.BuildDefinition.ExternalParameters.Policy).To(Equal("attestation"))
+				Expect(statement.Predicate.BuildDefinition.ResolvedDependencies[1].Name).To(Equal("icu4c-72_1-src.tgz.sigstore.json"))
+				Expect(statement.Predicate.BuildDefinition.ResolvedDependencies[1].Annotations).To(Equal(&result))
+			})
+
+			context("when the source was verified with its signature by an earlier run", func() {
+				it.Before(func() {
+					_, err := generator.WithPolicy(components.PolicyPGP).GenerateMetadata(release)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				it("verifies the source again with its attestation bundle", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(1))
+					Expect(attestationVerifier.VerifyCall.CallCount).To(Equal(1))
+					Expect(sourceRequests).To(Equal(1))
+				})
+			})
+
+			context("when the release has no attestation bundle", func() {
+				it.Before(func() {
+					release.Files = release.Files[:3]
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError("required files are missing from the release: no attestation bundle for icu4c-72_1-src.tgz"))
+				})
+			})
+
+			context("when there is no attestation verifier", func() {
+				it.Before(func() {
+					generator = generator.WithAttestationVerifier(nil)
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(`an attestation verifier is required for the "attestation" policy`))
+				})
+			})
+
+			context("when the policy accepts either", func() {
+				it.Before(func() {
+					generator = generator.WithPolicy(components.PolicyEither)
+				})
+
+				it("verifies the source with its signature first", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(1))
+					Expect(attestationVerifier.VerifyCall.CallCount).To(Equal(0))
+				})
+
+				context("when the signature does not verify", func() {
+					it.Before(func() {
+						signatureVerifier.VerifyCall.Returns.Error = fmt.Errorf("verifier failed")
+					})
+
+					it("verifies the source with its attestation bundle", func() {
+						_, err := generator.GenerateMetadata(release)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(attestationVerifier.VerifyCall.CallCount).To(Equal(1))
+
+						result, ok := generator.Verification("72.1")
+						Expect(ok).To(BeTrue())
+						Expect(result.Method).To(Equal("attestation"))
+					})
+
+					context("when the attestation does not verify either", func() {
+						it.Before(func() {
+							attestationVerifier.VerifyCall.Returns.Error = fmt.Errorf("attestation failed")
+						})
+
+						it("returns both errors", func() {
+							_, err := generator.GenerateMetadata(release)
+							Expect(err).To(MatchError("verifier failed\nattestation failed"))
+						})
+					})
+				})
+
+				context("when the release has no signature", func() {
+					it.Before(func() {
+						release.Files = append(release.Files[:1], release.Files[2:]...)
+					})
+
+					it("verifies the source with its attestation bundle", func() {
+						_, err := generator.GenerateMetadata(release)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(0))
+						Expect(attestationVerifier.VerifyCall.CallCount).To(Equal(1))
+					})
+				})
+			})
 		})
 
 		context("failure cases", func() {
+			context("when the verification policy is not supported", func() {
+				it.Before(func() {
+					generator = generator.WithPolicy("some-policy")
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(`unsupported verification policy "some-policy": must be one of "pgp", "attestation" or "either"`))
+				})
+			})
+
 			context("when there are missing release files", func() {
 				it("returns an error", func() {
 					_, err := generator.GenerateMetadata(components.IcuRelease{})
@@ -202,7 +747,7 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 				})
 			})
 
-			context("when the shasum file cannot be parsed correctly", func() {
+			context("when the shasum file has no entry for the source", func() {
 				it("returns an error", func() {
 					_, err := generator.GenerateMetadata(components.IcuRelease{
 						SemVer:         semver.MustParse("72.1"),
@@ -222,7 +767,19 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 							},
 						},
 					})
-					Expect(err).To(MatchError("unable to parse the SHASUM512 file"))
+					Expect(err).To(MatchError("SHASUM512.txt has no entry for icu4c-72_1-src.tgz"))
+				})
+			})
+
+			context("when the shasum file cannot be parsed", func() {
+				it.Before(func() {
+					release.Files[2].URL = fmt.Sprintf("%s/malformed-shasum", server.URL)
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(`failed to parse SHASUM512.txt: line 2 is not a sha512 checksum entry: "some-text"`))
+					Expect(sourceRequests).To(Equal(0))
 				})
 			})
 
@@ -275,8 +832,105 @@ a1aa65917e80e524c9b35466af83193001b1dfc030c5a084e02e2f71649a073e96382e9f561fb637
 						},
 					})
 					Expect(err).To(MatchError("verifier failed"))
+
+					Expect(filepath.Join(cacheDir, "sha512", fmt.Sprintf("%s.sig", checksum))).NotTo(BeAnExistingFile())
+				})
+			})
+
+			context("when the source cannot be downloaded", func() {
+				it.Before(func() {
+					release.Files[0].URL = fmt.Sprintf("%s/non-200", server.URL)
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(fmt.Sprintf("received a non 200 status code from %s/non-200: status code 418 received", server.URL)))
 				})
 			})
+
+			context("when the signature cannot be downloaded", func() {
+				it.Before(func() {
+					release.Files[1].URL = fmt.Sprintf("%s/non-200", server.URL)
+				})
+
+				it("returns an error", func() {
+					_, err := generator.GenerateMetadata(release)
+					Expect(err).To(MatchError(fmt.Sprintf("received a non 200 status code from %s/non-200: status code 418 received", server.URL)))
+					Expect(signatureVerifier.VerifyCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	context("RecordArtifacts", func() {
+		var dependencies []versionology.Dependency
+
+		it.Before(func() {
+			dependency, err := versionology.NewDependency(cargo.ConfigMetadataDependency{
+				ID:      "icu",
+				Version: "78.3",
+			}, "noble")
+			Expect(err).NotTo(HaveOccurred())
+
+			dependencies = []versionology.Dependency{dependency}
+		})
+
+		it("records gzip by default", func() {
+			artifacts, err := components.NewGenerator().RecordArtifacts(dependencies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(Equal([]components.ArtifactMetadata{
+				{Dependency: dependencies[0], Format: "gzip"},
+			}))
+		})
+
+		it("records the given format in the metadata json", func() {
+			artifacts, err := components.NewGenerator().WithFormat(components.FormatZstd).RecordArtifacts(dependencies)
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := json.Marshal(artifacts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(MatchJSON(`[{
+				"id": "icu",
+				"version": "78.3",
+				"target": "noble",
+				"format": "zstd"
+			}]`))
+		})
+
+		context("when the format is not supported", func() {
+			it("returns an error", func() {
+				_, err := components.NewGenerator().WithFormat("xz").RecordArtifacts(dependencies)
+				Expect(err).To(MatchError(`unsupported artifact format "xz": must be one of "gzip" or "zstd"`))
+			})
+		})
+	})
+
+	context("DecorateMetadata", func() {
+		var path string
+
+		it.Before(func() {
+			path = filepath.Join(t.TempDir(), "metadata.json")
+			Expect(os.WriteFile(path, []byte(`[{"id":"icu","version":"78.3","target":"noble"}]`), 0600)).To(Succeed())
+		})
+
+		it("adds the artifact format to the metadata written by retrieve.NewMetadata", func() {
+			err := components.NewGenerator().WithFormat(components.FormatZstd).DecorateMetadata(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(`[{"id":"icu","version":"78.3","target":"noble","format":"zstd"}]`))
+		})
+
+		context("when the metadata cannot be parsed", func() {
+			it.Before(func() {
+				Expect(os.WriteFile(path, []byte("%%%"), 0600)).To(Succeed())
+			})
+
+			it("returns an error", func() {
+				err := components.NewGenerator().DecorateMetadata(path)
+				Expect(err).To(MatchError(ContainSubstring("failed to parse metadata")))
+			})
 		})
 	})
 }
@@ -1,30 +1,36 @@
 package fakes
 
-import "sync"
+import (
+	"io"
+	"sync"
+
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+)
 
 type SignatureVerifier struct {
 	VerifyCall struct {
 		mutex     sync.Mutex
 		CallCount int
 		Receives  struct {
-			SignatureURL string
-			TargetURL    string
+			Signature io.Reader
+			Target    io.ReadSeeker
 		}
 		Returns struct {
-			Error error
+			VerificationResult components.VerificationResult
+			Error              error
 		}
-		Stub func(string, string) error
+		Stub func(io.Reader, io.ReadSeeker) (components.VerificationResult, error)
 	}
 }
 
-func (f *SignatureVerifier) Verify(param1 string, param2 string) error {
+func (f *SignatureVerifier) Verify(param1 io.Reader, param2 io.ReadSeeker) (components.VerificationResult, error) {
 	f.VerifyCall.mutex.Lock()
 	defer f.VerifyCall.mutex.Unlock()
 	f.VerifyCall.CallCount++
-	f.VerifyCall.Receives.SignatureURL = param1
-	f.VerifyCall.Receives.TargetURL = param2
+	f.VerifyCall.Receives.Signature = param1
+	f.VerifyCall.Receives.Target = param2
 	if f.VerifyCall.Stub != nil {
 		return f.VerifyCall.Stub(param1, param2)
 	}
-	return f.VerifyCall.Returns.Error
+	return f.VerifyCall.Returns.VerificationResult, f.VerifyCall.Returns.Error
 }
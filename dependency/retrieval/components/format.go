@@ -0,0 +1,82 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/paketo-buildpacks/libdependency/versionology"
+)
+
+// The compressions in which the compile action can produce ICU artifacts.
+const (
+	FormatGzip = "gzip"
+	FormatZstd = "zstd"
+)
+
+// ArtifactMetadata is the metadata of a dependency along with the compression
+// of the artifact that is compiled for it, so that the compile workflow can
+// produce the artifact in that format.
+type ArtifactMetadata struct {
+	versionology.Dependency
+	Format    string              `json:"format"`
+	Signature *VerificationResult `json:"signature,omitempty"`
+}
+
+// RecordArtifacts returns the given dependencies along with the artifact
+// format of the generator, and the signature their source was verified with
+// when the generator produced them.
+func (g Generator) RecordArtifacts(dependencies []versionology.Dependency) ([]ArtifactMetadata, error) {
+	if g.Format != FormatGzip && g.Format != FormatZstd {
+		return nil, fmt.Errorf("unsupported artifact format %q: must be one of %q or %q", g.Format, FormatGzip, FormatZstd)
+	}
+
+	var artifacts []ArtifactMetadata
+	for _, dependency := range dependencies {
+		artifact := ArtifactMetadata{
+			Dependency: dependency,
+			Format:     g.Format,
+		}
+
+		if result, ok := g.Verification(dependency.ConfigMetadataDependency.Version); ok {
+			artifact.Signature = &result
+		}
+
+		artifacts = append(artifacts, artifact)
+	}
+
+	return artifacts, nil
+}
+
+// DecorateMetadata rewrites the metadata file written by retrieve.NewMetadata
+// with the artifact format and signature of every dependency in it, keeping
+// the compact JSON that the workflow reads.
+func (g Generator) DecorateMetadata(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read metadata: %w", err)
+	}
+
+	var dependencies []versionology.Dependency
+	err = json.Unmarshal(content, &dependencies)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	artifacts, err := g.RecordArtifacts(dependencies)
+	if err != nil {
+		return err
+	}
+
+	content, err = json.Marshal(artifacts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	err = os.WriteFile(path, content, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("failed to write metadata: %w", err)
+	}
+
+	return nil
+}
@@ -9,8 +9,14 @@ import (
 
 func TestUnit(t *testing.T) {
 	suite := spec.New("icu-retrieval", spec.Report(report.Terminal{}), spec.Parallel())
+	suite("Attestation", testAttestation)
+	suite("Cache", testCache)
+	suite("Checksums", testChecksums)
 	suite("Dependency", testDependency)
+	suite("Keyring", testKeyring)
+	suite("ReleaseVersion", testReleaseVersion)
 	suite("Releases", testReleases)
+	suite("Sources", testSources)
 	suite("Verifier", testVerifier)
 	suite.Run(t)
 }
@@ -0,0 +1,203 @@
+package components
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	openpgp "github.com/ProtonMail/go-crypto/openpgp/v2"
+)
+
+var armoredKeyBlockPattern = regexp.MustCompile(`(?ms)^-----BEGIN PGP PUBLIC KEY BLOCK-----$.*?^-----END PGP PUBLIC KEY BLOCK-----$`)
+
+// LoadKeyring returns the contents of a keyring in the format of the ICU KEYS
+// file: armored public key blocks surrounded by free text. The location is an
+// http(s) URL, a file, or a directory whose regular files are read in lexical
+// order.
+func LoadKeyring(location string) (string, error) {
+	if uri, err := url.Parse(location); err == nil && (uri.Scheme == "http" || uri.Scheme == "https") {
+		resp, err := http.Get(location)
+		if err != nil {
+			return "", fmt.Errorf("failed to load keyring: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+			return "", fmt.Errorf("failed to load keyring: received a non 200 status code from %s: status code %d received", location, resp.StatusCode)
+		}
+
+		content, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("failed to load keyring: %w", err)
+		}
+
+		return string(content), nil
+	}
+
+	info, err := os.Stat(location)
+	if err != nil {
+		return "", fmt.Errorf("failed to load keyring: %w", err)
+	}
+
+	if !info.IsDir() {
+		content, err := os.ReadFile(location)
+		if err != nil {
+			return "", fmt.Errorf("failed to load keyring: %w", err)
+		}
+
+		return string(content), nil
+	}
+
+	entries, err := os.ReadDir(location)
+	if err != nil {
+		return "", fmt.Errorf("failed to load keyring: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var keyring strings.Builder
+	for _, name := range names {
+		content, err := os.ReadFile(filepath.Join(location, name))
+		if err != nil {
+			return "", fmt.Errorf("failed to load keyring: %w", err)
+		}
+
+		keyring.Write(content)
+		keyring.WriteString("\n")
+	}
+
+	return keyring.String(), nil
+}
+
+// Fingerprint returns the fingerprint of a key as upper case hex without
+// spaces, the form that pinned fingerprints are compared in.
+func Fingerprint(fingerprint []byte) string {
+	return strings.ToUpper(hex.EncodeToString(fingerprint))
+}
+
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(strings.TrimPrefix(strings.TrimSpace(fingerprint), "0x")), ""))
+}
+
+// readKeyring parses every armored public key block of the keyring, each of
+// which may hold several keys. Line endings are normalized, comment lines
+// starting with # are dropped, and text outside of the blocks is ignored.
+// Keys that are not pinned, when fingerprints are pinned, or that are revoked,
+// expired or cannot sign are left out, and the reasons are returned along with
+// the blocks that could not be read.
+func readKeyring(keyring string, pinned []string, now time.Time) (openpgp.EntityList, []KeyFailure) {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(keyring, "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	pins := map[string]bool{}
+	for _, fingerprint := range pinned {
+		pins[normalizeFingerprint(fingerprint)] = true
+	}
+
+	var (
+		entities openpgp.EntityList
+		failures []KeyFailure
+	)
+	for _, block := range armoredKeyBlockPattern.FindAllString(strings.Join(lines, "\n"), -1) {
+		list, err := openpgp.ReadArmoredKeyRing(strings.NewReader(block))
+		if err != nil {
+			failures = append(failures, KeyFailure{Reason: fmt.Sprintf("failed to read armored key: %s", err)})
+			continue
+		}
+
+		for _, entity := range list {
+			fingerprint := Fingerprint(entity.PrimaryKey.Fingerprint)
+
+			if len(pins) > 0 && !pins[fingerprint] {
+				failures = append(failures, KeyFailure{Key: fingerprint, Reason: "the fingerprint is not pinned"})
+				continue
+			}
+
+			reason := screenKey(entity, now)
+			if reason != "" {
+				failures = append(failures, KeyFailure{Key: fingerprint, Reason: reason})
+				continue
+			}
+
+			entities = append(entities, entity)
+		}
+	}
+
+	return entities, failures
+}
+
+// screenKey returns why the key cannot be used to verify signatures, or an
+// empty string when it can. Subkeys are checked when a signature is verified,
+// at the time the signature was made.
+func screenKey(entity *openpgp.Entity, now time.Time) string {
+	if entity.Revoked(now) {
+		return "the key is revoked"
+	}
+
+	selfSignature, err := entity.VerifyPrimaryKey(now, verificationConfig)
+	switch {
+	case errors.Is(err, pgperrors.ErrKeyRevoked):
+		return "the key is revoked"
+
+	case errors.Is(err, pgperrors.ErrKeyExpired):
+		if expiry, ok := keyExpiry(entity.PrimaryKey, selfSignature); ok {
+			return fmt.Sprintf("the key expired on %s", expiry.UTC().Format(time.RFC3339))
+		}
+
+		return "the self-signature of the key expired"
+
+	case err != nil:
+		return "the key has no valid self-signature"
+	}
+
+	if _, ok := entity.SigningKey(now, verificationConfig); !ok {
+		return "the key cannot sign"
+	}
+
+	return ""
+}
+
+// keyExpiry returns when the key expires according to its self-signature.
+// The lifetime counts from the creation of the key, not of the signature.
+func keyExpiry(key *packet.PublicKey, sig *packet.Signature) (time.Time, bool) {
+	if sig == nil || sig.KeyLifetimeSecs == nil || *sig.KeyLifetimeSecs == 0 {
+		return time.Time{}, false
+	}
+
+	return key.CreationTime.Add(time.Duration(*sig.KeyLifetimeSecs) * time.Second), true
+}
+
+// userID returns the user ID of the primary identity of the key, which v6
+// keys need not have.
+func userID(entity *openpgp.Entity, now time.Time) string {
+	_, identity := entity.PrimaryIdentity(now, verificationConfig)
+	if identity == nil {
+		return ""
+	}
+
+	return identity.Name
+}
@@ -0,0 +1,109 @@
+package components_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testKeyring(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+	)
+
+	context("LoadKeyring", func() {
+		var dir string
+
+		it.Before(func() {
+			dir = t.TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, "b.asc"), []byte("second key"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "a.asc"), []byte("first key"), 0644)).To(Succeed())
+			Expect(os.Mkdir(filepath.Join(dir, "c"), os.ModePerm)).To(Succeed())
+		})
+
+		it("reads a file", func() {
+			keyring, err := components.LoadKeyring(filepath.Join(dir, "a.asc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyring).To(Equal("first key"))
+		})
+
+		it("reads the files of a directory in lexical order", func() {
+			keyring, err := components.LoadKeyring(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyring).To(Equal("first key\nsecond key\n"))
+		})
+
+		context("when the keyring is a URL", func() {
+			var server *httptest.Server
+
+			it.Before(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					switch req.URL.Path {
+					case "/KEYS":
+						fmt.Fprint(w, "some keys")
+					default:
+						w.WriteHeader(http.StatusTeapot)
+					}
+				}))
+			})
+
+			it.After(func() {
+				server.Close()
+			})
+
+			it("downloads the keyring", func() {
+				keyring, err := components.LoadKeyring(fmt.Sprintf("%s/KEYS", server.URL))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyring).To(Equal("some keys"))
+			})
+
+			context("when the download returns a non 200 code", func() {
+				it("returns an error", func() {
+					_, err := components.LoadKeyring(fmt.Sprintf("%s/non-200", server.URL))
+					Expect(err).To(MatchError(fmt.Sprintf("failed to load keyring: received a non 200 status code from %s/non-200: status code 418 received", server.URL)))
+				})
+			})
+		})
+
+		context("when the keyring does not exist", func() {
+			it("returns an error", func() {
+				_, err := components.LoadKeyring(filepath.Join(dir, "missing"))
+				Expect(err).To(MatchError(ContainSubstring("failed to load keyring")))
+				Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+			})
+		})
+	})
+
+	context("when keys cannot be used for verification", func() {
+		for _, example := range []struct {
+			file   string
+			reason string
+		}{
+			{file: "expired.asc", reason: "4F25ACEC9BA7ECE41FE2F418D5B0ACFC14B706E4: the key expired on "},
+			{file: "revoked.asc", reason: "4CB119C98764F5590EA2FE60E856902A8C0ADD9B: the key is revoked"},
+			{file: "encryption.asc", reason: "36945A5B8E134C659201076AE7FB6C101575C628: the key cannot sign"},
+		} {
+			example := example
+
+			it(fmt.Sprintf("rejects the key of %s", example.file), func() {
+				keyring, err := components.LoadKeyring(filepath.Join("testdata", example.file))
+				Expect(err).NotTo(HaveOccurred())
+
+				verifier := components.NewVerifier().WithPublicKeyBlock(keyring)
+
+				_, err = verifier.Verify(bytes.NewReader(nil), bytes.NewReader(nil))
+				Expect(err).To(MatchError(ContainSubstring("no valid pgp keys provided: ")))
+				Expect(err).To(MatchError(ContainSubstring(example.reason)))
+			})
+		}
+	})
+}
@@ -1,1352 +1,11 @@
 package components
 
-// This is the contents of https://github.com/unicode-org/icu/blob/main/KEYS on 2023-4-14
-const (
-	icuPublicKeyBlock = `This file contains the PGP keys of ICU developers.
-Compare to http://www.apache.org/dist/ant/KEYS and read https://www.apache.org/dev/openpgp.html for background
-
-To import these keys:
-   gpg --import KEYS
-
-To update this file:
-replace $KEYID is your key's ID:
-  (gpg --list-sigs  $KEYID && gpg --armor --export $KEYID ) >> KEYS
-
-
-pub   2048R/825EA2CD 2010-11-02
-uid                  ICU Project @IBM (International Components for Unicode Development at IBM) <icuintl@us.ibm.com>
-sig 3        825EA2CD 2010-11-02  ICU Project @IBM (International Components for Unicode Development at IBM) <icuintl@us.ibm.com>
-sig 3        FD8FABF1 2013-12-12  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-sig          0F0DE47D 2014-09-17  Steven R. Loomis (CODE SIGNING KEY) <srl@icu-project.org>
-sub   2048R/1D06B1BF 2010-11-02
-sig          825EA2CD 2010-11-02  ICU Project @IBM (International Components for Unicode Development at IBM) <icuintl@us.ibm.com>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-Version: GnuPG v2.0.14 (GNU/Linux)
-
-mQENBEzQQMUBCACbwbw7tuTWgwPsDAdQTWGO355jP75oBLHwGgEwV+OCKtxkNXNw
-wrJqXst83vmD1dEJyHflQww+d+Olj90IefQGfR+K7O005C2nky7eNGIomxaP52Y/
-90+tmw8qtsI4nsPWPuVj4WdFvlFgUwIZ0SmX4CauVzg0Ris8f0taxg7PH9zEvICs
-G/WAXdB9em08WDD6ruhMAvDF4W8Yy7mpGmdWiFD+B9OC006tv+GzYAvUHRFeCnnT
-SoKRiBeLejW+t4kpdMnEfC9ILAYBEEjNYvBIyPdPKBwNfy0yjRebsUf0eNmjGTpk
-VPlfofjVaUaOZytUOQvntYpocMX+377DGQIdABEBAAG0X0lDVSBQcm9qZWN0IEBJ
-Qk0gKEludGVybmF0aW9uYWwgQ29tcG9uZW50cyBmb3IgVW5pY29kZSBEZXZlbG9w
-bWVudCBhdCBJQk0pIDxpY3VpbnRsQHVzLmlibS5jb20+iQE4BBMBAgAiBQJM0EDF
-AhsDBgsJCAcDAgYVCAIJCgsEFgIDAQIeAQIXgAAKCRBEznvygl6izdyHB/0c++b/
-5s355bj5P7AuMxLsMCIu60hkBN3O4IBkI5zRah+rdChAlbDLIDbqepKTyTNYIKPT
-m3szJAy3g8e8C7Spn+p8I2HFNrLg1uKmeiO1bJoorseRQXb0tJuwgpmkAaXWzCX/
-ZQFPygWzJXKeREdI8gTyFjGMwUyfpIfAGD8cz0f+AYgCiLGM9VXa4jXPXOLco8R/
-TeHe09Pu914RWNSQIFsI+dGyzdrHG9taHJ5K0q71iiZOEGoWBQWlCMq8bHrImvjP
-ipHyWdwowYg3bDNrs25GMexiS7O5rrJyezchIWbcV/54vTcNQy4co7hZCad/Ar4B
-SYb1E6lBZnFf3FmSiQEcBBMBAgAGBQJSqRp0AAoJEKyl2+H9j6vxhG0IAKsbN6WA
-07JZTqY9oyaidLWMuBUYjb4ETSCsy1jvrlv1WJbjNOvS59ilDmnxA3sRKQBY0CSI
-IhBjc+Pm3478F28w0bSj3a4lw3T6EwX1UJMqkzNP5vGrkO8ZOMa561uTtQ6rCc8p
-/QS2+elXSAbbn7ytg7dAjEdCgViC99b/0Q7Ixi/UgXpIkaXYJ8DWU+TMtbCfXceK
-cKTLT7VlYnmlHgCwHOBs51TzqOsB91rXcvW+4LA3EZ2ITRQYAkWq5POn7cy7bVsd
-DS8FyHuU8qgUHCp4m9SHkb0gokQlIkwBjFA18TZJ1WKyGQQRZQVEUoPHoXSjFC42
-il70JdaiGTAtRDqJAhwEEAECAAYFAlQZ8l4ACgkQqqmunA8N5H3MIw/+K4G0Zce6
-7a1CBTM0YyIwnkWBST98IxcqOLJfrq5rQlKEwn7J+CLlwekDq5C3rlYxHtVQ/6/V
-Ncj3Uz/LEURrZZFr5dylTkFtkKnvDgVvJdyUA+RcGnQSM5q+6JthDul1NvPTZE9M
-rmKwK1DGrSJ1lsszFvk2tS3mq1IZOP3KH74o22Ks7ONP3GM8TDgGxy+pfGOzfEap
-Q0kwwG9wnybH4suYKUtpPm5BR1yQkktCnOoJLV5QYEoYQLAF2ez74jVjffEByl6S
-GVr6CaqscaRLKtm7sp6p/hCKOkV7rdIy0UEtMGluJCY8y9My+ij+SaTM2F0nl71F
-8zjY0SoKtXKo8GsucIMT1Ow9+igGDto4RzNnNd8MkhoMWKizGlOWrFR+TK/zpaj8
-AVx2Cw7OPPh4vB8yPa3x7u9cXJcejzryMStADkQg3fFSKdcELNOjIKTAe/+BEAiK
-DAs6Ka1/yRpjz7esWqYjI1hU70wwjXIPPMwAig4IYfvTBE02ilUO0NETUPWSiwfz
-8ZONAFjMCkxb7Vm0dhDVzVt4vCPWUop2Ip3QjnXCmDCj1KxoolYaw4fqKh+1khHM
-1Aspk9nSfLG4VrXHTCYjLYD1OpPByOWiAqOJnbW/wqFhjKrrAHR5AyjsremtaQlv
-r3lmoQLs9qmdP4oeQG4nR4UqzhjG2bNLV9+5AQ0ETNBAxQEIANp35mwgILWWQI36
-F2SyWFTFF8zupjzqlcF4Vx1Mjs2rQYErv1qs09TJfO+uxPRAva0fKik9PFmZ0vLM
-7UAveZgaknzjTQveOaAJuT5OjudoyptG81ilHO66+e9RnuyV6gSINnt6wnq4n8KR
-PoEmI/nxMhvmBCPw+YmxG3ZcU5Zko4GtjLA4J01nag7jY+LH08qFfPcKsADmx7Et
-CRuBcXgQ202kF3o7BjULcLV3R8vni3jp5nljH0vJG5USSY2ZR1bFiLtTCMO8cm+j
-Iuay25iWil5DaejASIXzbzTtSYg9Skxet29AWjlrt1+zKZsMSLPbKJ6qhdW5XNU4
-Qo9ycx0AEQEAAYkBHwQYAQIACQUCTNBAxQIbDAAKCRBEznvygl6izZwgB/4gOqGH
-c0Cc165PZggwbf1nyJZ+af0QiKWasgoUzo4a7OSIISmBYPGnW/VP4EMsaNEhnqWK
-3Tu+cuidW6z7MWHRsqLcvYUKeiZE2IhnMP85ad2S14UOHE8cbkLwfIGe+rIu+zYp
-zyc5bcRYqeLvd5Dk1H/eW0/hsadW9qpSWQRGxWPEsMqaAhiret0ntlGH2igswZ7S
-YNYLNtK78wppFqqFHfFvnVu1XNmiTDDuk2IokPIGRjeWO59eSR/kotg0zox4S+CJ
-bRdQdPq2ofDNqKCIEtg6y4yM+dc7c7n1Txk53fMBMzXfGnLGfbTFLo1hpYdC5sep
-AZA1XWLRgZ3+5+vD
-=NkHr
------END PGP PUBLIC KEY BLOCK-----
-
-
-pub   4096R/0F0DE47D 2014-09-17 [expires: 2019-09-16]
-uid                  Steven R. Loomis (CODE SIGNING KEY) <srl@icu-project.org>
-sig 3        0F0DE47D 2014-09-17  Steven R. Loomis (CODE SIGNING KEY) <srl@icu-project.org>
-sig          FD8FABF1 2014-09-17  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-Version: GnuPG v2.0.14 (GNU/Linux)
-
-mQINBFQZ3doBEACpnA2AbRRVxu9TeDTnjHtz6h5NyMyGZIPZOX7Iv2bNS0JSAS/H
-9f2WP9+3ANHuKI32rYh0snKpWjYsgUCxZ8x2Wp4cu8YnTvvpL6a5fn5n04dTyd4s
-/gPLb51qHIL8L6MDfK2ARgukntzfLR2CoL+RwGLDOjNlnoGNw65XEdjkzZ65s05Q
-/YHdlMYJE6gQ7VzJf7JYO6z6MpclLSj106TDs0hmOSetRm66nt+G7q1iY/jMXSMm
-bs3CFe4MNoVlRnl2THFZWjGSrVNYkenKexrjJ5HD9sm2nFF5pXgMQBoyOE5gic/b
-H5hMeh3v4vmhxRL5GPmI9Ir25LwqYCaWZDu6TC8mDDJj82YQMOzvElpRU/5V8KVc
-R7e4FN7D6tXDPb8IMgHb1U7zEnKYAc+uAS5NQ6Vq/S3NoueEQNk+rwqy44yIigtD
-iOJOnLvf1JFUPNFUxRu2JUy7YzvvcyGG9PY7bxQEb/MLwa424GWRTHV4Yw+hHP6D
-+XgylCyd36wfwgF48+gFeDza9gMPPDgGqJu059PjEEG9ELgBFkTVjM0+rqq1M6Jk
-+WPFRgM9ioccwdVIvfVZNnkp+EUjpl/PHLYlFvP0orkK3SGc1jaqW5m3Y6P60RzM
-7OovZqtqUDTEfMZ4bQKT4EdLayBPFALvz4TgndxrZwoPi2QGmFU9Dy+snwARAQAB
-tDlTdGV2ZW4gUi4gTG9vbWlzIChDT0RFIFNJR05JTkcgS0VZKSA8c3JsQGljdS1w
-cm9qZWN0Lm9yZz6JAj4EEwECACgFAlQZ3doCGwMFCQlmAYAGCwkIBwMCBhUIAgkK
-CwQWAgMBAh4BAheAAAoJEKqprpwPDeR9St0P/RJzlQDOgtD/rq8ThV5clhcgzCQU
-J34bC9+SdvQVtG6cOu+/mE9fjPcRuMSC2saj3e6KdfdCF51ZUelFgg7Nf4VjzKXK
-egYFe11CJSYFt0YQyT3CwlX5a6lKv9BH4RJPdAjeACSNCLvuZYlkxfAFPAwJFMAH
-CeiY6P7Llm2sHHIIubK+yFviZiD1ic92ADt2llM06VwniCS2Cs0kx4NAl70jd/vW
-B/j9+k+PphZFA2IUuB80qnTdrEb+nVLQ9n0SMFwFm89bEm8IhXe0Lb//jl5ZbZCr
-afn02GvdSqU73jqOfEHS7hHemB1KSM4hEdWGFRhUf0+Xpc31aOsnKY5Wu89A8Sz/
-sKp554qAhGmBGeEjkKbw0t+k4liP+LW2efmVBt+/6msF+TGq0Rr5vFQEUNCYeIzz
-DS5nKDlKvRwgx/41RnSUYiLJS9GkCB6skxIQZvW2G7rnkGQkgmP3/tkTTX0EP0rT
-GlW4ivfp+AKoYozkZ5FNLXC06yzCSKYL0z9uoL38uR6opXo4768byzzkBOvSut3Q
-V9gbvAtZvwEibwOt0A4UhbIyv96+Oi2WI66q37Wl33F/QIsxpC9UjXju1RrAajOv
-0gY8VMxwuWHN8ewslykX5rohxgA7mkTf/YeELSiYEM1WpmKmj30uJapgDwVRpsTC
-dfSvIc/nOLji4u0iiQEcBBABAgAGBQJUGfLZAAoJEKyl2+H9j6vx+58IAMIzjJTv
-9n7Yq2iyncFyg7fmPmlKo28l6cIrmveGtzLWgO7pXw9hZy8mTwUAhg9nMnNbnjDw
-qSA+YH8rNy0MB/AaTj46faE/RlqB8bCUlnLlRk9b6IxKODOVAarFk0ltCUeNt1IP
-w8YziwUaJewmb2//tEjwG4RuliQeo8EPcg2MejsxctNJ1gWQxZFOSMk2M6I9NwW6
-xMUmqGzzKdPChdwzOBaHK2f4lB3IlPqpJVqAWuXdINzi91tCIKfo508hJSpzGm/n
-Lq4yQHJPjQjxTldFBxC8Ji5yYIQ2ZcWLaYndWH4aVbAkEelU3eIp44mU4tqhqCP6
-zJJjdh87G81yUoE=
-=pu4w
------END PGP PUBLIC KEY BLOCK-----
-pub   2048R/FD8FABF1 2013-12-12 [expires: 2020-10-03]
-uid                  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-sig 3        FD8FABF1 2017-10-04  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-uid                  [jpeg image of size 10375]
-sig 3        FD8FABF1 2017-10-04  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-sig 3        FD8FABF1 2013-12-12  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-sig 3        FD8FABF1 2013-12-12  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-sub   2048R/A6EB1469 2013-12-12 [expires: 2019-10-04]
-sig          FD8FABF1 2017-10-04  Steven R. Loomis (ICU Project) <srl@icu-project.org>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQENBFKpD3cBCADklZIzuPsYrEy08rASB+MRnFF8hqDDUkDHuvS0bJwEDge2EEHT
-l9rnUOt3SGtSrokeniQbwut26vuY/V59SSpwClytbHovIRJ/ovjq3nUasCRPOxDL
-mlmftkRGrO6WYH6hSzmRy2x3NTgQOlE/YRtOya3CAVlkiybpYXL3L1mJA4e80NpF
-V4UG7vyfw44OHFeO6mInqkcxVgKkxwp3wBbck3FEySEZT0DTT+sQHI52fgUftb+P
-xBH1s58lLAub4o2z1hnImPN/BR0lZpiNj59vzNlqmcnRHTpa6KIz+Qeep9dwLL7i
-71lMfCsEgVyDZxkQxFpNoRYRHRkfvBEY7JLpABEBAAG0NFN0ZXZlbiBSLiBMb29t
-aXMgKElDVSBQcm9qZWN0KSA8c3JsQGljdS1wcm9qZWN0Lm9yZz6JAT4EEwECACgC
-GwMGCwkIBwMCBhUIAgkKCwQWAgMBAh4BAheABQJZ1XNBBQkMz/5KAAoJEKyl2+H9
-j6vxKIEH/0aFGlFOtgXNHKEG7F1mVxhjNyFlZD5O/9t8oI46vYCgVfwsn6BsHFpv
-9yAtzA6z26Zt2NsPjH6dVRfc3sGCojBIKQ/lxnyQqjAYaEbVVQ/3jhAhgnm+uAF4
-3Rr54g+6FL7aHB33nDy9FmRtmQsyVf1OySR7VXbHpwEVzWeLrgmPKR1t8P1hdiSo
-YfroXVAPy2pynXUWgWbMbuqAcTj0iAQQ7LuVOVZ8bYA922LRgUxg/ZPxiGGm2g3M
-LF/QVWMH6FAHtSf9DOaFjZqQuypkUoOZZuFfNMvKaYIe+XviZRtWls5M6H8wOz6c
-bFrLBV5XBBwKbOXULpDv/3+oehDO22vR/wAAKJ3/AAAomAEQAAEBAAAAAAAAAAAA
-AAAA/9j/4AAQSkZJRgABAQEASABIAAD/4hscSUNDX1BST0ZJTEUAAQEAABsMYXBw
-bAIQAABtbnRyUkdCIFhZWiAH3QALAAUADwAzADFhY3NwQVBQTAAAAAAAAAAAAAAA
-AAAAAAAAAAAAAAAAAAAA9tYAAQAAAADTLWFwcGwAAAAAAAAAAAAAAAAAAAAAAAAA
-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABFkZXNjAAABUAAAAGJkc2NtAAAB
-tAAABA5jcHJ0AAAFxAAAACN3dHB0AAAF6AAAABRyWFlaAAAF/AAAABRnWFlaAAAG
-EAAAABRiWFlaAAAGJAAAABRyVFJDAAAGOAAACAxhYXJnAAAORAAAACB2Y2d0AAAO
-ZAAABhJuZGluAAAUeAAABj5jaGFkAAAauAAAACxtbW9kAAAa5AAAAChiVFJDAAAG
-OAAACAxnVFJDAAAGOAAACAxhYWJnAAAORAAAACBhYWdnAAAORAAAACBkZXNjAAAA
-AAAAAAhEaXNwbGF5AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAbWx1
-YwAAAAAAAAAhAAAADGhySFIAAAAUAAABnGtvS1IAAAAMAAABsG5iTk8AAAASAAAB
-vGlkAAAAAAASAAABzmh1SFUAAAAUAAAB4GNzQ1oAAAAWAAAB9GRhREsAAAAcAAAC
-CnVrVUEAAAAcAAACJmFyAAAAAAAUAAACQml0SVQAAAAUAAACVnJvUk8AAAASAAAC
-am5sTkwAAAAWAAACfGhlSUwAAAAWAAACkmVzRVMAAAASAAACamZpRkkAAAAQAAAC
-qHpoVFcAAAAMAAACuHZpVk4AAAAOAAACxHNrU0sAAAAWAAAC0npoQ04AAAAMAAAC
-uHJ1UlUAAAAkAAAC6GZyRlIAAAAWAAADDG1zAAAAAAASAAADImNhRVMAAAAYAAAD
-NHRoVEgAAAAMAAADTGRlREUAAAAQAAADWGVuVVMAAAASAAADaHB0QlIAAAAYAAAD
-enBsUEwAAAASAAADkmVsR1IAAAAiAAADpHN2U0UAAAAQAAADxnRyVFIAAAAUAAAD
-1mphSlAAAAAOAAAD6nB0UFQAAAAWAAAD+ABMAEMARAAgAHUAIABiAG8AagBpzuy3
-7AAgAEwAQwBEAEYAYQByAGcAZQAtAEwAQwBEAEwAQwBEACAAVwBhAHIAbgBhAFMA
-egDtAG4AZQBzACAATABDAEQAQgBhAHIAZQB2AG4A/QAgAEwAQwBEAEwAQwBEAC0A
-ZgBhAHIAdgBlAHMAawDmAHIAbQQaBD4EOwRMBD4EQAQ+BDIEOAQ5ACAATABDAEQg
-DwBMAEMARAAgBkUGRAZIBkYGKQBMAEMARAAgAGMAbwBsAG8AcgBpAEwAQwBEACAA
-YwBvAGwAbwByAEsAbABlAHUAcgBlAG4ALQBMAEMARCAPAEwAQwBEACAF5gXRBeIF
-1QXgBdkAVgDkAHIAaQAtAEwAQwBEX2mCcgAgAEwAQwBEAEwAQwBEACAATQDgAHUA
-RgBhAHIAZQBiAG4A6QAgAEwAQwBEBCYEMgQ1BEIEPQQ+BDkAIAQWBBoALQQ0BDgE
-QQQ/BDsENQQ5AEwAQwBEACAAYwBvAHUAbABlAHUAcgBXAGEAcgBuAGEAIABMAEMA
-RABMAEMARAAgAGUAbgAgAGMAbwBsAG8AcgBMAEMARAAgDioONQBGAGEAcgBiAC0A
-TABDAEQAQwBvAGwAbwByACAATABDAEQATABDAEQAIABDAG8AbABvAHIAaQBkAG8A
-SwBvAGwAbwByACAATABDAEQDiAOzA8cDwQPJA7wDtwAgA78DuAPMA70DtwAgAEwA
-QwBEAEYA5AByAGcALQBMAEMARABSAGUAbgBrAGwAaQAgAEwAQwBEMKsw6TD8ACAA
-TABDAEQATABDAEQAIABhACAAQwBvAHIAZQBzAAB0ZXh0AAAAAENvcHlyaWdodCBB
-cHBsZSBJbmMuLCAyMDEzAABYWVogAAAAAAAA81IAAQAAAAEWz1hZWiAAAAAAAABq
-egAAPKoAAAYFWFlaIAAAAAAAAGhwAACq+AAAH7dYWVogAAAAAAAAI+wAABheAACt
-cWN1cnYAAAAAAAAEAAAAAAUACgAPABQAGQAeACMAKAAtADIANgA7AEAARQBKAE8A
-VABZAF4AYwBoAG0AcgB3AHwAgQCGAIsAkACVAJoAnwCjAKgArQCyALcAvADBAMYA
-ywDQANUA2wDgAOUA6wDwAPYA+wEBAQcBDQETARkBHwElASsBMgE4AT4BRQFMAVIB
-WQFgAWcBbgF1AXwBgwGLAZIBmgGhAakBsQG5AcEByQHRAdkB4QHpAfIB+gIDAgwC
-FAIdAiYCLwI4AkECSwJUAl0CZwJxAnoChAKOApgCogKsArYCwQLLAtUC4ALrAvUD
-AAMLAxYDIQMtAzgDQwNPA1oDZgNyA34DigOWA6IDrgO6A8cD0wPgA+wD+QQGBBME
-IAQtBDsESARVBGMEcQR+BIwEmgSoBLYExATTBOEE8AT+BQ0FHAUrBToFSQVYBWcF
-dwWGBZYFpgW1BcUF1QXlBfYGBgYWBicGNwZIBlkGagZ7BowGnQavBsAG0QbjBvUH
-BwcZBysHPQdPB2EHdAeGB5kHrAe/B9IH5Qf4CAsIHwgyCEYIWghuCIIIlgiqCL4I
-0gjnCPsJEAklCToJTwlkCXkJjwmkCboJzwnlCfsKEQonCj0KVApqCoEKmAquCsUK
-3ArzCwsLIgs5C1ELaQuAC5gLsAvIC+EL+QwSDCoMQwxcDHUMjgynDMAM2QzzDQ0N
-Jg1ADVoNdA2ODakNww3eDfgOEw4uDkkOZA5/DpsOtg7SDu4PCQ8lD0EPXg96D5YP
-sw/PD+wQCRAmEEMQYRB+EJsQuRDXEPURExExEU8RbRGMEaoRyRHoEgcSJhJFEmQS
-hBKjEsMS4xMDEyMTQxNjE4MTpBPFE+UUBhQnFEkUahSLFK0UzhTwFRIVNBVWFXgV
-mxW9FeAWAxYmFkkWbBaPFrIW1hb6Fx0XQRdlF4kXrhfSF/cYGxhAGGUYihivGNUY
-+hkgGUUZaxmRGbcZ3RoEGioaURp3Gp4axRrsGxQbOxtjG4obshvaHAIcKhxSHHsc
-oxzMHPUdHh1HHXAdmR3DHeweFh5AHmoelB6+HukfEx8+H2kflB+/H+ogFSBBIGwg
-mCDEIPAhHCFIIXUhoSHOIfsiJyJVIoIiryLdIwojOCNmI5QjwiPwJB8kTSR8JKsk
-2iUJJTglaCWXJccl9yYnJlcmhya3JugnGCdJJ3onqyfcKA0oPyhxKKIo1CkGKTgp
-aymdKdAqAio1KmgqmyrPKwIrNitpK50r0SwFLDksbiyiLNctDC1BLXYtqy3hLhYu
-TC6CLrcu7i8kL1ovkS/HL/4wNTBsMKQw2zESMUoxgjG6MfIyKjJjMpsy1DMNM0Yz
-fzO4M/E0KzRlNJ402DUTNU01hzXCNf02NzZyNq426TckN2A3nDfXOBQ4UDiMOMg5
-BTlCOX85vDn5OjY6dDqyOu87LTtrO6o76DwnPGU8pDzjPSI9YT2hPeA+ID5gPqA+
-4D8hP2E/oj/iQCNAZECmQOdBKUFqQaxB7kIwQnJCtUL3QzpDfUPARANER0SKRM5F
-EkVVRZpF3kYiRmdGq0bwRzVHe0fASAVIS0iRSNdJHUljSalJ8Eo3Sn1KxEsMS1NL
-mkviTCpMcky6TQJNSk2TTdxOJU5uTrdPAE9JT5NP3VAnUHFQu1EGUVBRm1HmUjFS
-fFLHUxNTX1OqU/ZUQlSPVNtVKFV1VcJWD1ZcVqlW91dEV5JX4FgvWH1Yy1kaWWlZ
-uFoHWlZaplr1W0VblVvlXDVchlzWXSddeF3JXhpebF69Xw9fYV+zYAVgV2CqYPxh
-T2GiYfViSWKcYvBjQ2OXY+tkQGSUZOllPWWSZedmPWaSZuhnPWeTZ+loP2iWaOxp
-Q2maafFqSGqfavdrT2una/9sV2yvbQhtYG25bhJua27Ebx5veG/RcCtwhnDgcTpx
-lXHwcktypnMBc11zuHQUdHB0zHUodYV14XY+dpt2+HdWd7N4EXhueMx5KnmJeed6
-RnqlewR7Y3vCfCF8gXzhfUF9oX4BfmJ+wn8jf4R/5YBHgKiBCoFrgc2CMIKSgvSD
-V4O6hB2EgITjhUeFq4YOhnKG14c7h5+IBIhpiM6JM4mZif6KZIrKizCLlov8jGOM
-yo0xjZiN/45mjs6PNo+ekAaQbpDWkT+RqJIRknqS45NNk7aUIJSKlPSVX5XJljSW
-n5cKl3WX4JhMmLiZJJmQmfyaaJrVm0Kbr5wcnImc951kndKeQJ6unx2fi5/6oGmg
-2KFHobaiJqKWowajdqPmpFakx6U4pammGqaLpv2nbqfgqFKoxKk3qamqHKqPqwKr
-davprFys0K1ErbiuLa6hrxavi7AAsHWw6rFgsdayS7LCszizrrQltJy1E7WKtgG2
-ebbwt2i34LhZuNG5SrnCuju6tbsuu6e8IbybvRW9j74KvoS+/796v/XAcMDswWfB
-48JfwtvDWMPUxFHEzsVLxcjGRsbDx0HHv8g9yLzJOsm5yjjKt8s2y7bMNcy1zTXN
-tc42zrbPN8+40DnQutE80b7SP9LB00TTxtRJ1MvVTtXR1lXW2Ndc1+DYZNjo2WzZ
-8dp22vvbgNwF3IrdEN2W3hzeot8p36/gNuC94UThzOJT4tvjY+Pr5HPk/OWE5g3m
-lucf56noMui86Ubp0Opb6uXrcOv77IbtEe2c7ijutO9A78zwWPDl8XLx//KM8xnz
-p/Q09ML1UPXe9m32+/eK+Bn4qPk4+cf6V/rn+3f8B/yY/Sn9uv5L/tz/bf//cGFy
-YQAAAAAAAwAAAAJmZgAA8qcAAA1ZAAAT0AAACg52Y2d0AAAAAAAAAAAAAwEAAAIA
-AAAIACQAVwCkAQYBRgGTAe8CTAK0AycDoAQnBLoFWAYABrUHeAhGCRsJ+ArhC9IM
-zg3RDxQQaBG8ExkUdhXZF0kYuBotG6YdKx6vIDghyiNgJPomkygwKdUrZSzsLnUv
-9zF5Mvk0bzXjN084sToMO2M8tD3+P0VAhUHFQvlEHkVFRmpHkUi2SdxLAUwmTUlO
-bU+PULFR01L2VBlVPFZSV2pYf1mWWq1bw1zZXe5fAmAWYSliPGNOZGFldGaBZ4Bo
-gmmDaoRrhmyJbYpujG+OcJFxk3KVc5d0m3WVdnJ3UHgteQt56nrIe6Z8hn1nfkh/
-KoANgPGB1oK7g5+EhYVxhmOHX4hniXuKoYvXjR2Ob4/PkTySkJOylNaV+JcbmECZ
-ZpqOm7mc6Z4cn1KgjaHKovikDqUmpj+nWah3qZiqvKvlrRKuQ694sLGx67MftFC1
-frart9W4/boku0q8b72Vvr2/5cEPwjbDUcRhxWPGVsc7yBLI3MmbylXLDcvFzILN
-Rs4Rzt/PrdB80UrSGdLn07PUf9VJ1hLW2teZ2FHZBtm82nTbLtvo3KXdYt4h3uDf
-n+Bf4R/h3+Kc41bkDuTD5XPmIObL53LoGOi86WTqD+rC63XsKOzd7ZLuSO7/77jw
-c/Ev8ezywfOp9JL1dvZa9z/4Kvkf+iP7QvyG/gv//wAAAAcAHgBKAIsA4wEqAW4B
-wAIZAnQC2gNHA70EQQTSBW0GEQa9B3QINgkACdUKrQuODIENlg7MEAARPBJ6E7oV
-AxZPF6MY+BpUG7QdGh6GH/IhZCLWJE0lxycvKJMp9ytXLLMuCy9gMLAx+DM8NHc1
-rTbeOAo5MDpWO3c8kD2dPqw/ukDKQdlC6UP5RQpGGkcqSD1JTkpgS3FMg02UTp5P
-qVCyUbtSxlPQVNlV4lbqV/NY+1oDWwtcFF0dXiFfIWAfYR1iG2MZZBZlE2YPZwpo
-BWkAaftq9WvwbOdt2G7Ib7ZwpHGQcntzZHRLdTF2Fnb6d954wnmmeod7Z3xKfS5+
-FX8Cf/OA6oHpgvCD/YUShi2HTYhuiYuKp4vEjOKOAY8hkEORZpKNk7WU4JYNlzyY
-ZZmHmqqbzJzxnhefP6BpoZaixqP5pS6mZqegqNqqFKtOrIatvq72sCyxZLKbs9W1
-D7ZLt4m4x7oBuzq8cL2jvtG/+cEbwjbDTMRcxWnGcsdtyFrJQ8oryxPL+8zizcjO
-rs+T0HjRXtJD0yTUAdTd1bnWlNdv2ErZJdn/2tnbstyM3WbeQN8b3/fg1OGx4o3j
-auRH5STmAebd57nolOlp6j3rEevl7Lvtku5q70TwIPD98dzyu/Oc9I71lPas99X5
-C/pG+3n8mf2c/oD/Sf//AAAABQAWADUAZACkAPUBLQFqAbACAAJTArADFgOBA/UE
-cAT1BYMGHAa+B2UIEwjGCX4KQwsqDCYNJg4lDy4QNxFHElcTbhSGFaUWxxfxGRka
-SBt5HK0d4x8bIEQhaiKNI7Ak0yXwJwsoISk1KkArSCxKLUcuQS82MCoxHDIGMukz
-yTSqNY02bzdQODM5FDn1Otc7uDyaPXw+Xj9AQCJBBUHpQs5Ds0SYRX5GY0dISCxJ
-EEn1StlLvEyhTYZOaU9IUCdRBlHlUsRTolSBVV5WO1cZV/ZY01mvWo1baVxCXRtd
-817MX6Rge2FSYihi/mPTZKllfmZTZyhn+mjLaZxqbWs9bA1s3G2rbnlvRXAScN5x
-qXJ1cztz/3TBdYN2Q3cCd794e3kyeed6m3tKe/h8rH1xflp/R4A0gSKCEIL/g+2E
-2oXHhrOHnYiIiXCKaotpjGiNaI5pj2mQaJFmkmKTXZRVlUyWQZdEmFOZZ5p7m5Gc
-pp26ns2f3aDrofejAKQHpR6mU6ePqMyqC6tNrJGt2q8osHmxzrMntIO13LckuGi5
-prrcvAu9Mr5Sv2zAgMGRwp/DrcS4xcLGy8fWyOLJ78r+zA/NI846z1PQb9GN0rLT
-2dUB1irXU9h92ana1twF3TXeZ9+b4O/iheQo5d/nv+nQ7CHuuPGn9N/4XPwV//8A
-AG5kaW4AAAAAAAAGNgAAmj0AAFllAABRRwAAjYQAACXWAAAXCgAAUA0AAFQ5AAKK
-PQACRR4AAYzMAAMBAAACAAAACwAiAD0AWgB3AJUAswDRAPABEAEwAVEBcgGUAa8B
-ywHoAgYCJAJDAmMCgwKjAsQC5gMIAysDTgNxA5UDugPfBAQEKgRQBHcEngTGBO8F
-GAVBBWsFmAXHBfYGJgZXBooGvQbyBycHXweXB9IIDghMCI0IzwkTCVkJoQnsCjgK
-hwrXCykLgQveDDsMmgz6DVwNwA4lDowO9Q9gD8wQOhCqERwRjxIEEnoS8hNyE/IU
-dRT5FX8WBxaRFx0XrBg8GM8ZZBn7GpQbLxvLHGwdGR3GHnYfKB/cIJEhSiIEIsAj
-fiQ/JQIlxyaNJ1koRyk3KisrISwaLRYuFC8TMBUxGDIdMyM0LDU6Nkc3UThWOVI6
-RTsvPA885j22PoA/RkAIQMVBgEJRQ0JEM0UnRh1HE0gLSQNJ+0rzS+lM303UTshP
-u1CuUaZSwFPaVPVWEVctWEhZYlp7W5JcqF27Xs5f32DwYgBjFmQzZVJmdmecaMdp
-9WsmbFptkW7JcANxPXJ5c7R08HY6d5F4+np5fBR90H+2gcGD5YYNiCqKLYwnjiSQ
-JJIolDKWQ5hbmn2cpp7voV6jzKY4qKKrCq1yr9yySbS5ty65sLxFvvHBt8SXx43K
-lM2L0F/TN9YQ2Orbwt6W4WjkOOa26PrrVO258B7yevTC9uv46fq6/Fj9uv7p//8A
-AAANACcARgBnAIcAqADKAOwBDwEyAVcBfAGfAb4B3QH+Ah8CQQJkAogCrALRAvYD
-HANDA2oDkgO6A+MEDAQ2BGEEjAS4BOUFEgVABW4FoQXUBgkGPgZ1Bq4G5wcjB2AH
-nwffCCMIaAiwCPoJRwmWCegKPQqTCuwLRwurDA8MdgzeDUcNsw4gDo8O/w9yD+YQ
-WxDTEUwRxxJEEsITRhPMFFQU3xVrFfkWiRccF7EYSBjhGXwaGhq5G1ob/RymHVQe
-BB63H2wgJCDeIZsiWyMeI+MkqiV0JkEnDyfnKMQppSqJK3IsXi1PLkUvPzA9MT8y
-RDNMNFo1bDZ+N5E4oTmuOrc7uzy5PbM+qD+aQIpBd0JoQ11EVUVORkhHREhBST5K
-PEs6TDhNNk40TzNQMVEvUjlTSFRYVWpWfFePWKNZtlrJW9xc7l4AXxJgI2E1YkZj
-WWRuZYRmnme5aNdp9msYbDttYG6Gb6xw03H6cyJ0SnV0dqN31XkKekN7gXzFfhF/
-ZIDBgiaDlIULhomIDomzi3KNNI76kMOSkpRlljyYGJn3m9mdv5+6obujv6XIp9Wp
-56v9rhiwNrJZtH62pLjMuvW9Ib9RwYPDusX0yDTKd8zDzybRi9Pz1lrYwdsl3Yjf
-6eJK5KrnCukv6y7tFu7n8KryZ/Qr9gT4BPpD/OP//wAAABIANQBbAIMArADVAP4B
-KQFVAYMBqwHRAfgCIQJKAnUCoALNAvoDKQNYA4gDuQPqBB0EUASEBLkE7wUmBV4F
-mgXYBhgGWgadBuIHKgd0B8EIEAhjCLkJEwlxCdIKNwqfCwoLewvzDG4M6w1qDe0O
-cQ75D4MQEBCgETIRxxJeEvgTlBQyFNIVdRYaFsIXbRgbGMwZfxo2Gu8bqhxpHS8d
-+B7DH5IgZCE5IhIi7iPNJK8llSZ9J2koXClSKkwrSSxLLVAuWi9nMHgxjTKlM8A0
-5TYNNzk4aTmdOtY8FD1YPqA/7UE+QptEBEVxRuZIY0nrS4BNIU7RUIxSF1NkVLJW
-AldUWKhZ/1tbXLleHV+FYPFiYmO6ZRBmaGfBaRxqe2vdbURur3AgcZdzE3SUdfp3
-VniyehB7b3zQfjV/nYEKgnyD9IVyhvWIfonTix2MaI2zjv+QSZGTktyUI5VplqyX
-75kvmm+brpzsni6fgqDbojqjoaUQpoioC6mZqzGs1K6AsDKx6rOmtWi3Lrj3usC8
-i75VwB/B6MOvxXTHN8j5yrrMec4uz+TRm9NU1Q3WyNiD2j7b+d20327hKeLj5J7m
-Ked+6M7qF+tZ7JHtuu7V7+fw4/Hb8r/zoPRw9UH1//a993P4I/jU+Xj6HfrA+1z7
-+PyS/Sf9vP5Q/uD/b///AABzZjMyAAAAAAABDEIAAAXe///zJgAAB5IAAP2R///7
-ov///aMAAAPcAADAbG1tb2QAAAAAAAAGEAAAnKUAAAAAw7GoAAAAAAAAAAAAAAAA
-AAAAAAD/4QCARXhpZgAATU0AKgAAAAgABQESAAMAAAABAAEAAAEaAAUAAAABAAAA
-SgEbAAUAAAABAAAAUgEoAAMAAAABAAIAAIdpAAQAAAABAAAAWgAAAAAAAABIAAAA
-AQAAAEgAAAABAAKgAgAEAAAAAQAAADugAwAEAAAAAQAAAFMAAAAA/9sAQwACAQEC
-AQECAgECAgICAgMFAwMDAwMGBAQDBQcGBwcHBgYGBwgLCQcICggGBgkNCQoLCwwM
-DAcJDQ4NDA4LDAwL/9sAQwECAgIDAgMFAwMFCwgGCAsLCwsLCwsLCwsLCwsLCwsL
-CwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsL/8AAEQgAUwA7AwEiAAIR
-AQMRAf/EAB8AAAEFAQEBAQEBAAAAAAAAAAABAgMEBQYHCAkKC//EALUQAAIBAwMC
-BAMFBQQEAAABfQECAwAEEQUSITFBBhNRYQcicRQygZGhCCNCscEVUtHwJDNicoIJ
-ChYXGBkaJSYnKCkqNDU2Nzg5OkNERUZHSElKU1RVVldYWVpjZGVmZ2hpanN0dXZ3
-eHl6g4SFhoeIiYqSk5SVlpeYmZqio6Slpqeoqaqys7S1tre4ubrCw8TFxsfIycrS
-09TV1tfY2drh4uPk5ebn6Onq8fLz9PX29/j5+v/EAB8BAAMBAQEBAQEBAQEAAAAA
-AAABAgMEBQYHCAkKC//EALURAAIBAgQEAwQHBQQEAAECdwABAgMRBAUhMQYSQVEH
-YXETIjKBCBRCkaGxwQkjM1LwFWJy0QoWJDThJfEXGBkaJicoKSo1Njc4OTpDREVG
-R0hJSlNUVVZXWFlaY2RlZmdoaWpzdHV2d3h5eoKDhIWGh4iJipKTlJWWl5iZmqKj
-pKWmp6ipqrKztLW2t7i5usLDxMXGx8jJytLT1NXW19jZ2uLj5OXm5+jp6vLz9PX2
-9/j5+v/aAAwDAQACEQMRAD8A/P8A8Xz3DW1tiaQAyn+I88fWuv0qzuJlT97Icjn5
-jWJ4r0gyWtpwf9Yf5CvRNXW2+Hnge41nWYy0VpGCsYIDTOcBUGeMkn+tfzhjsXKG
-FoRhrKTaS7u6sfsmGo3rVXLZWv8AcVLTRpgpaVyoA6lsAfrXO6r8aPCOg6zJp+p+
-JbJLqA7ZQjs4jbrhmUEA+2e9ed6nceK/2gRcTv8A8ecBUJZw5W3hB4BxnMh4OWPb
-GBXR+Ef+Cdeva/ZRalb6hYxM6h47MxFcA9sZxmvUwOT0Zz5MbXal2jbR+rvf7l6s
-MRHGTo+2wdC8e76r8Lfeei6XHF4h0tbvw7fRXttL92WGTejH0yP5Gqt3pdwhHzyH
-Puaw7T9lv4k/s33/APb/AIQtYdesZGMt5p8UrLvQAbhtPBcAHaR/+v2DTtDj8ZeE
-dN1rSYpY7bVLSO6i3r8wV13fN6EdK4c4w88lqxanzU5bP9Gv6uThJSxkWqlPlmt1
-/kzyecXETnfJIfxNJ+9blppAf9411niTw09rIxK9OuKxPsboSCoOD3FXhsT7RXRh
-UpcujQuuaCTa6eQOWlb+QroP2p/CUt/pfhLRywitbl5bu4JHyuEVVVT68ufxrR17
-SQbfSRgYMzA84/u10Xx0hn8a6tbw6HNp9ufDsj290lwH8x4x5LvJHjg4ZlXafqeO
-K+Rni6nLgqsdo+1bfbon63eh9hlGUzzCtiIwjeyg2l2MT4Z/C+0+HWkNNOY2jVVL
-bV3c46dOT6V6b4a8daDHLFajxHa6ddykGOGdTEWx2AYD1HSsHUvhxfwXWn3uk3t7
-MbQFpQZMgEptBEX3CRnIyD271SvvFDeB7ezXxNquo6vm5kujb6pEsjuuAUjj+Vcb
-SPlx1LcnFfacG0aePw08dXrJta/8B/5ntcSzxWVzp4HD4V8j0779f+ArnuIsdUuR
-9nuWsJIhyDvGW4ycevXFcj4S8CRaTpGo6XbJ5UNlfSFF252rLiXaPYF2xXmst3qP
-ivVdLfU9M0HW4o/LaWS7uJLWSFSpMuzysc7wOeOCPTn3vQ9Ih0rTdLi8O2ki219b
-vc3fnX32lrU5URKrElmyNwwccLn69/iDg1/Y0a9Oa+JPfayfl2+/ZanxWDxTePlh
-50nFxutt7/1f5HjHxC8MfZ9x2feyQcV53Lo7CVwAPvHtXvnxG04O7jAATk5rye80
-ljdSbQpG418BkuKcqerNMwpRjIm8U22yLRQuPmlYfqldH+0p8KINMtNS8YWKXAv7
-nTP7K3b18lFlki3HaeUdig+YdRwegrnvFF4uNDBCnMx/9CSvY/jxap4r+A+rWSkJ
-LLEhTno+8bf1xXgYzFVcBRwDi7KTmpf4XNXPr+F6tsbWiknzcq+9aP1TKXxG8QL8
-HvgjrXiXUbOXUo9FsHu/KhwDO6qMKD2zuHPQAZr478R/tGfEb4weKEv10f8Asi9h
-iFjFcWksSPZjeDui+0IyqQBtLbTkZ/Dv/j/+2Wyfs2T+Gb2CW01y62WWoQSrgqFX
-5yOxVhjr61hfsxftoaPL4dfQ/ivZNBPBlre7hiEu125O5T+dfp/COGlhMsVL2d7y
-96+zXlfRpWXk0z0c7zCnis0VOtiXCKi+W3fze6e6+XQ6jxh+1hP8NPEb2fxN0+9m
-023t0UTixh8qSUEM4WSNiSGBIyeARngdPrH4d6zY6/4Etr7w8kkdldIs8KyJtIR1
-DLkep/pXxH+1n+0H4K+I/hbTfCfhfTTqGq61eQW13ffYlthbwtMqHYo5JIPQde/W
-vuO5kj0nQY7e2jFuBBEiRDgQoiBFX64GT7mtfEWVCOVwwyespLlXlHf5LS780fIU
-cVOpiqsVLmitb26vp/WljifHzYL4PLfe5968qvIke6kLgZ3HORk13fi7UPML89f8
-a4K4kPnvww56AjFfD5PQVKnY4cfU5pHK+MdXAl0gO2F8wnPr8yV9a/Aj4GyftJ6B
-rVjeXj6bBFbCO1umQsou+Gj3LxuUY+YDqGFQfCH4Y+FPCEqyvpn2+5jUlZp41dl+
-hbpzivb/AIJa3FperyRqgt7aY+cPmBLPgBs+5wD+Nfpdbwsr1sPhViJJuDd4rzd9
-/LTofL4XjqOFr1KmHum7NSdt1tpqfnX+2N+yLJL4nuNO8eWb6J4oslIEgyYr6EHh
-s9JADjDjnacHkED448ZeAvEnw212VNV02WJ1G1GVTJHMOgZWAwRz9fav6HPjJ8I/
-C37SngtdK+INqrT226TT9QiCi702UjAkiY/XBQ5VgSCOa+HfjD8FvEn7NXiIWniX
-T4tR0y4YCx1O2iJtrj/Y28mOT1jOT/dLc19Pg+Eq2UpU6cual5/Z8v609NjvrcV4
-biO86i5K/W20n3T/AKa89z4w/YR/Zi1Xx/8AFOy8T/FGxu7bw/oEgvYxcQmP7fMp
-zFGqtzsVvnLccqoGea+4fFHio3Urkt+YrtvB37OHizVfhTqutavEttqWtJa/2Zpc
-v7qZIo3Ls8hPCM4YBUIBAHzEFsDxvxWl94e1KSx8RWtzY3kJ+eGdCjr7jPUe4yK/
-PuPsjxlHMadatF+y5Vyu3up3d1fv172aNMjzLDVaM6VKaclJ31u3tr6dOxk+JtQ3
-k7cVyk13vlYgkZNX9d1IYzwT1Fc5LfN5jbSQM9K8rBUOWJrXqan2hoNpClwAEC7s
-jpmuy0fSI54wIZfJYchgOh9a42DMcikdR6V0Wkam8OMHg1/Wfs0z8K9o0dt4Q1S+
-vbJZYvPgHIbzBtwQcHg9elfnf/wWrj+JXhbxpofjJPG+qv4WtZ/stnptnM9k2jzh
-dwnR4yPMZsjLtll2gLgE1+g2neJN0QBbjFfGf/BbadZP2btNmg2+b/wkFsMk8DMb
-HPPH/LOuXH4enHCVGt0r/ce5w1XqPNcOlazklZ+ej9NOu66Hr/7D/wC1f4j+NX7N
-/hO7+MV/Bf6tqdqUTVljCJfSISjxS4+VLhGUhhwHI3KBkqO++JfhaLxzoZt/F1mm
-o26j5JU4mhOOq55U/Q/hXxp/wRA+IEWo+AfH/g7Vx9ottNvLTU4YZm8xYzJGY5Cn
-YDfAG47nPevtnUtXW1k3Id1s/wAjdzEff2rSlClmWEiq0VJSVmnqnbR7nPnWHnk+
-Z1aVN25XdNaaOzW22j2Wh8c/Gz4V3fw+ujPaym+0pjgT7CrwHssq9vZhwfY8V5v9
-o/zivuLxd4ei1aKWC5jSSKVSDlQQQexz2rxjVv2LrHUtRln03VZ7CGU7lt1QOsXH
-IBPOM5+nSvzHOfD+pTqueWq8X9m+q9G916u68+n0OX8VxnDlxj95de/3dfwPaFuH
-UfK7fnUi6ncJjbKwxRRX3iqzX2n958tyrsTR+Ir2IYS4cflXJ/GT4YaF+0D4bh0j
-4wWC61p1vcLdRwSSvEFlUEK2Y2UnAZuM45oopSqSmuWTuiofupKcNGuq3ML4N/sz
-eCP2ftfm1T4P6M2i3txbGzldL64lWSIvv2lJJGU/NyDjIycHk16I2q3DuWaZyWGD
-6EfSiilCTpR5YOy8jSvVnipupWk5SfVu7+9h/atx5YTzWKqMAHmmG+lY8uaKKv29
-T+Z/ezHkj2P/2YkBPgQTAQIAKAIbAwYLCQgHAwIGFQgCCQoLBBYCAwECHgECF4AF
-AlnVc0QFCQzP/koACgkQrKXb4f2Pq/FdFQgArUxV0TaJWfM/ymf2HCnh2LlqOBod
-whKXzwnwCAzVUWX4QKm2MlWhcSfPbafVQlc4na5ecW7QHqp24vs3kAlzVKP9nUpA
-dJHP76Sc4UdOCmRBJqfL4OHDRbFLIp27ZnVF8RbYF74fqSPSWVefwya9Pb/FjS50
-rnZRaCTEm2aRx+Pm+SD5X1levN1T4YC+fLxECZ8ERoRQiuIED9YEUCE1E68M1IFy
-xvy+mFz9Ck3DCBdL45v1IDVQz+IRw4j1PJzwnI8sHnyoXyJpmVvyinoXiqqQWMUR
-tG7HMkTg8d8PlWWllZxp6E5jItP+g60NbsgAkOFNpkB8vhXC8SHRgguoMokBPgQT
-AQIAKAUCUqkWjQIbAwUJB4YfgAYLCQgHAwIGFQgCCQoLBBYCAwECHgECF4AACgkQ
-rKXb4f2Pq/HGdggAqnLiZBfqSUVtrMPzoHyDNwGl6szKWLHRoJ+x0z7smiGI4DUe
-oaUL7VrKcQuj1Ww6fNLya7YqLTxLobG91nY1HfEFyBaycUHhYfjj87HG+hRYuQRR
-W4l441rEFSZlm42Ui7ZAlAECZ2WLOnxRNR/i5b/18B7R3T7DIChgtSEeSUoNV7Le
-C9vWOgQ5LHmxQWy9u/QDpVBGIJgMgycjFNyOfF/x9EB2Edxa1kpPIkzIRJeXrj30
-RbRTMfifn0iBVoUKNnkDtNARP/SV+y3d7YeiQWqBgh4SB3Sw3yKL4LbUZ5qrzTjw
-TGMQb8q2apwlHzJM6L8HeYPlvDCXZh3JMskRTokBPgQTAQIAKAUCUqkWFQIbAwUJ
-B4YfgAYLCQgHAwIGFQgCCQoLBBYCAwECHgECF4AACgkQrKXb4f2Pq/Fm8Af/RZ/P
-IYzw0q3za636DfepvXQTKC7aCemUgktxGp4qoKBOv0380cA8ERWUo1ajs93ea69i
-qNAkZFCFB6DblmDNbKFut89nBK4TlJlehMdM1C4F+XexX/9Tds/ToncO04Q9V/dM
-efVnqAvlPZPjhffBd+est+66xYMUxR4+Apby7TWfl27LKEzl3MBElt+e8Ki43Z6W
-SSCDDHChNKWLD5EzYoVv9HJV6CRgGUmg5Y94Yoo4vSVzBttjQ4JeED9mtd41OhDV
-7jwOjf5XWJcW5DXPzIyAt/bWJu+09WbX4qNiJ51xQ+bom91M3uaj/Mq7Hy+qO802
-airAH8j7Mckj2yOuEbkBDQRSqQ93AQgA+pgk1zYDFRi6wsRyujz6w4JPvU+YKMVH
-nr9c5agZsdG+8rQqVQ9WoFKKde2UiicVjAEPHsl73FI1CiJYU7H7dfgbebq5kxbg
-k9h685ea+LVLwSA+Z1OURlmpPNbU1QcYKxvC5L4ET5ExlQ7ngBVhxFd5I51bIb7o
-/NQFd+fZkHQmGVu+ejkjx7yH3soPlXLmvEIRKKD2Rkcz8G0ugnfAfDL66NAoWGuv
-y9wgLhqLlrXZFJG5s9cHliAH7cCU8DJ47sUDo8UP841Z4KcBfYZSFoOomjLdfpHz
-isiLp/hlUSk6Ay7VBYr1w136uSpfVgrr9voypi4iOMs8PSZl8wUlaQARAQABiQEl
-BBgBAgAPAhsMBQJZ1XNQBQkK7srZAAoJEKyl2+H9j6vxfK0IALXlPtgZPyMgwDOQ
-FnyMbZiUNI0AVNaKlkuP3AxpRA9WJ3Y9S8Mm9Ns+4u5e8GieE6rvde3MjD2RcpQL
-sS1ZyWB+EeB8YUrWeSHnKHFF50LaE4hDzeBYHJpNc1UelK9u7DqpMnL2oIR0XO5i
-6EUl1ASJOdEBy3IOiBss0R54WsMslRpcR8UCBRS9Ea9zpgd0S+FjMWGGRkytn6P2
-bzIVXe0kzXNgvWkOztdga2kp/r4VlG83TpOh0MQ+0+qcLzR90aHLEbLT1gKmV8XW
-xH+rnw2J4OH3SKWbGFyP1ZTpEYRM9vQ4U40UQtiXF5gNJ8Bh9rqzh2actPo9/Q+W
-DyXQOcA=
-=x0WA
------END PGP PUBLIC KEY BLOCK-----
-
-pub   rsa4096 2015-11-15 [SC]
-      FFA9129A180D765B7A5BEA1C9B432B27D1BA20D7
-uid           [ultimate] Fredrik Roubert <fredrik@roubert.name>
-sig 3        9B432B27D1BA20D7 2015-11-15  Fredrik Roubert <fredrik@roubert.name>
-sig 3        C44AB8B850327DF9 2015-11-15  Fredrik Roubert <fredrik@roubert.name>
-sig 2        80D88B22D4330331 2015-11-21  Antoine Amarilli <a3nm@a3nm.net>
-sig          C191C1EE0DED33EA 2015-11-23  Tobias Klausmann <klausman@google.com>
-sig 3        6DFD2ACE211BAAD0 2015-11-23  David Lazar <dlazar@google.com>
-sig 3        4445C665FFAD9AE0 2015-11-23  David Lazar <dlazar@gmail.com>
-sig          216D7E92EB61819E 2015-11-25  Damien Desfontaines <ddfontaines@gmail.com>
-sig 3        9C187BA29B2157F8 2015-12-01  Joakim Roubert <joakim@roubert.name>
-sig          1C2C9D2A7564DC9F 2015-12-02  Aline Abler <alinea@riseup.net>
-sig          4484F5552D1E8BB4 2015-12-02  Gian-Maria Daffré <gian-maria.daffre@giammi.com>
-sig          4719E4FC1E16C930 2015-12-02  Cedric Sonderegger <cs@cedi.ch>
-sig          6C6580E77BD756C4 2015-12-02  Daniel Pocock <daniel@pocock.pro>
-sig          7F61756177978F76 2015-12-08  Steinar H. Gunderson <sesse@debian.org>
-sig          47712171F2ED62FB 2016-04-06  Elmar Heeb (private) <elmar@heebs.ch>
-sig 3        2DFF526BB17F76C6 2016-06-11  Jonathan Schleifer <js@heap.zone>
-sig          A42898C74BF155DB 2015-12-03  Raoul Bourquin <raoulb@bluewin.ch>
-sig          147473802F35F6CB 2018-09-11  Jeff Genovy <Jeff.Genovy@microsoft.com>
-sig          8CE9B4A72C6CE242 2018-09-11  Jeffrey Genovy <jeffgenovy@gmail.com>
-sig          1E0A288397AE739E 2019-02-28  Muharem Hrnjadovic <mh@cryptostars.co>
-sig          2F3ADC8F35AD8D67 2019-02-28  Muharem Hrnjadovic <mh@cryptostars.co>
-sig 3   P    C0C076132FFA7695 2019-03-01  Jonathan Cross <jcross@gmail.com>
-sig          A7C247B4ED0AFFFE 2019-05-02  Gerd Flaig <gefla@pond.sub.org>
-sig          6DB4EC14CFB5E44F 2019-02-28  Dario Duran <dario@utxo.ch>
-sig 3        CB7079481CC60B3A 2019-03-03  Lukas Zeller <luz@plan44.ch>
-sig 3        D07AD2C2D75D1CF7 2019-03-03  Patrick Brunschwig <patrick@enigmail.net>
-sig 3        DB1187B9DD5F693B 2019-03-03  Patrick Brunschwig <patrick@enigmail.net>
-uid           [ultimate] Fredrik Roubert <roubert@google.com>
-sig 3        9B432B27D1BA20D7 2015-11-15  Fredrik Roubert <fredrik@roubert.name>
-sig 3        C44AB8B850327DF9 2015-11-15  Fredrik Roubert <fredrik@roubert.name>
-sig 2        80D88B22D4330331 2015-11-21  Antoine Amarilli <a3nm@a3nm.net>
-sig          C191C1EE0DED33EA 2015-11-23  Tobias Klausmann <klausman@google.com>
-sig 3        6DFD2ACE211BAAD0 2015-11-23  David Lazar <dlazar@google.com>
-sig 3        4445C665FFAD9AE0 2015-11-23  David Lazar <dlazar@gmail.com>
-sig          216D7E92EB61819E 2015-11-25  Damien Desfontaines <ddfontaines@gmail.com>
-sig 3        9C187BA29B2157F8 2015-12-01  Joakim Roubert <joakim@roubert.name>
-sig          1C2C9D2A7564DC9F 2015-12-02  Aline Abler <alinea@riseup.net>
-sig          4484F5552D1E8BB4 2015-12-02  Gian-Maria Daffré <gian-maria.daffre@giammi.com>
-sig          4719E4FC1E16C930 2015-12-02  Cedric Sonderegger <cs@cedi.ch>
-sig          6C6580E77BD756C4 2015-12-02  Daniel Pocock <daniel@pocock.pro>
-sig          7F61756177978F76 2015-12-08  Steinar H. Gunderson <sesse@debian.org>
-sig          47712171F2ED62FB 2016-04-06  Elmar Heeb (private) <elmar@heebs.ch>
-sig 3        2DFF526BB17F76C6 2016-06-11  Jonathan Schleifer <js@heap.zone>
-sig          A42898C74BF155DB 2015-12-03  Raoul Bourquin <raoulb@bluewin.ch>
-sig          147473802F35F6CB 2018-09-11  Jeff Genovy <Jeff.Genovy@microsoft.com>
-sig          8CE9B4A72C6CE242 2018-09-11  Jeffrey Genovy <jeffgenovy@gmail.com>
-sig          1E0A288397AE739E 2019-02-28  Muharem Hrnjadovic <mh@cryptostars.co>
-sig          2F3ADC8F35AD8D67 2019-02-28  Muharem Hrnjadovic <mh@cryptostars.co>
-sig 3   P    C0C076132FFA7695 2019-03-01  Jonathan Cross <jcross@gmail.com>
-sig          6DB4EC14CFB5E44F 2019-02-28  Dario Duran <dario@utxo.ch>
-sig 3        CB7079481CC60B3A 2019-03-03  Lukas Zeller <luz@plan44.ch>
-sig 3        D07AD2C2D75D1CF7 2019-03-03  Patrick Brunschwig <patrick@enigmail.net>
-sig 3        DB1187B9DD5F693B 2019-03-03  Patrick Brunschwig <patrick@enigmail.net>
-sub   rsa4096 2015-11-15 [E]
-sig          9B432B27D1BA20D7 2015-11-15  Fredrik Roubert <fredrik@roubert.name>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQINBFZIiQoBEADCWPLK62Qyz2AkaXLY8N2SSv4iFL3SMdJW6JAlb4ELrO67w81L
-GliVieSnu+vq0kU1xOhuB2Jrsy8g7MuMZ2Dy+/ySVPmgK3EllNqan3OdSPzg6tk6
-x1kG/DOCDqbu9d8y5i9CoW+h4hYGd+NFFu/Vs0osKyNV2O8+XPJe+26ihwCALnz5
-VKeSmZeSyf/FjIyYvEPFhk3LESJuCJzoGKdcoQlSKUy39kBy0+yYvzr4e6mUnicO
-InppVgeon4lWxytHVVfaVGnvp5A72q718xHaFrfhDGrb6CPh8pzwlKcsic6fV5UH
-oxSdXfxu7KBUOyY28kEBAzRx/qAmX5O4jr1FZxsWMG75X7AxOiH/33NdE6flGpId
-JQvk4AOT/mG0mmLce3NU39FQBR+jZtASdeHFeyCflcUdUOYzLSeq1HVj8FbUAExM
-sjAzvXcNZURM42iDLrcp1sc1Z/4PIEJy9pFnm0tlda70CQKU5Fzf6lj0WxNgCuyH
-gs7SaRepSlFydAPafQ7Qe4oC7fwrkazqNEf/NAEWanONB+fsDU4vWHwT9xy7Sz+m
-wpnwwUgjUMjT01Itnf9HpgRCc+VVTG4EE0L01zPb7bOdYVhc8QIXbR9g7lzdjw2q
-yGh/IOvrU48BEQW8iv7NLjWCOpZpGfgD78GZy7Eod8O5IF9J4ZkvT1RebQARAQAB
-tCZGcmVkcmlrIFJvdWJlcnQgPGZyZWRyaWtAcm91YmVydC5uYW1lPokCOgQTAQgA
-JAIbAwULCQgHAwUVCgkICwUWAgMBAAIeAQIXgAUCVkiJYAIZAQAKCRCbQysn0bog
-1wbtD/wME4lvhG5e/sKH0UM1AiRmfCwp3zGJFPoEjNIfI+b1+CzA/a9IB/8oqtsd
-ylc8ofxAE6U21jtIR189DloDc5sYSzZU23+h7hU3sV7CKTXxh7R6+V611IvM2xy2
-n5SNIP35NViYfpIxz2Nu6fQM9x2qkP7O1yYmaIOfYrwvklxEeylX65JD1vSic8wP
-GzuYBjXE+JFdYphYR+VBp1+n1wG2dpN5Y+0HTydgGNQVrbWjv+qgrpiz3WmylI+8
-MxwlvQY11xh2VvOOVa9W4kGUjZ2HBdqT0xVqlmtPWHQgVr/89LtEd3sVoNqozj6V
-WY0MyQCrowkxcu1VmZN3rYOFIPok9elCoTf7pEX1Js5r7KqbYNhWlSkyrZqeu3cF
-BeritkrcyCYH4jL5zJYMs6q6RHC/Uoh/s0URxfJt3xPpiSqAxYRq8L8bhMJngB8f
-vz5pW//+0cezYGHgKFMkm2uqwrqERzL+he9njQueCtTIUdNzhbDEhl3Pi3YCgYbj
-MMfXF4h/OWoGfNiGd5+0AfwOHKmaaLkRNDKv8Efacz0WA07EF3Y8FbCluYsBKWNB
-BI1tg4avUnzL70H8bIU93XI7haNtxdoBm4XtTBijro3DFus/A2rII8wE1z3uEZkv
-Bo0WjSiAL/aOuV5LkI2hu8IuPqWWNlcTUIVtyd0AAcwuKef0cIicBBMBCAAGBQJW
-SIomAAoJEMRKuLhQMn35btQD/i1zaMjfRakgj2ze8ngTcLsv0HWU5kq5qWIfdJcN
-g+G1HEpkBTYDpFEQd40OzevAEezkR+pCbL65G6HDYoBUNaJ/bJCBdYX0B5Wlu2oi
-nA6ZRW70DlHf/xGd9HsXlfTihJiW5swTxM/xiVlS9qu32ifrcP4S3E21hIi97FIb
-gfjgiQIcBBIBCgAGBQJWUOxRAAoJEIDYiyLUMwMxn3MP/RbzPh5bqP+gUAqmYtqc
-CxOa+GZooS5kPoZD9SBz9sWVHfN9PLYzUoDTXt72lOGjwpNkibVx6XhDyH7+Nsf/
-8mRHKJbqaBymSuMc0Bw24xb0i34fI0EpTdYeN2aXi4Zg19DWvz4K602424fYz0NI
-a1V4d/8OkyhYOqjlt8voG6QSW67rq4tpYXg6OidTithg5WcDQwLFpA0Nw3LMfkfi
-7ZpjVzNjVtCZr+bE4kADvugeSKHe6EWoV6ZuCdhm8XS+1mBO/9Xc216Mb3L8ZGsa
-hbgvfQZHQpe3y99TwT2QV4y05YxlBRiMof45WwTKqI+VoUr950pFdifBQsHCrkh9
-MeNLRO+v/XBwiqR65fVrfY8lFisvxWhYR2HOlswqT0oLVQYhRWSTCBa867PxK/KO
-7GiiUk5z3o4PYpbHRq071Q0b8Sw4e3tLQliiDaDJxRR1u+qdr/oMFIjaU6a+jMv3
-jP5UfGbxarDDvnsTiPIVWy05e922xB5HaE1ukYKr/QoagVKtig82NX/TWk9O1xS7
-9Wfnqg9I2sENdAjLBvB1z6BfLfeVUGifYI0oIlzndKtLlQDDi1IcUaAaQjoACp8d
-aji2Co41B0bZ+zabp4fyOwKIxp+ilyKh68c8hZvj2hWHcRckeURT0Q8jTmuRBxix
-Zb4AK1McA2cXvklsxJhsXWmQiF4EEBEIAAYFAlZSzmoACgkQwZHB7g3tM+oq9gD/
-YVqx0qNLvsiIqkkhCK+B4IXFhyRd7bVxq2bqBgYj8SAA/2jEn5CZVcUdZikR4CRY
-EJown4Xy3q4yko3a9bYwBUcCiQIcBBMBCgAGBQJWUx/xAAoJEG39Ks4hG6rQaPAP
-/il6zHLcQ66pucQKoQVZIYPIt+hfNyj2U3DW3uf4rntD20h81UGSZYhYLTNNeEiH
-vtwLYhRL5aBbp3gbFeg9P90IeUAaf1SvLAjKeSRSs+b36QlYhnZoFO4lbtYwR8Kg
-KGC4u+Bc3afcGppHsbAu4iZqkXBGfLBYqC+PPhp/alJkW3cR+rXtsNoBD9u3TKNK
-ZSUABKchA//G5u8Omj4pAJPf8hlDgbEjGp9HxUVu5I42u6pnan0Slmugp8RXWFrj
-Wh6MULphoCS0YajpWVni8oVfRN5mt7FGqnhq+lLnbTCq1Hy3J0wpPIjx/djmF1VA
-ebgNilrxhbkzUMjLvaJodG3IeWy1k4ZcUroJ8NDcT6EIP8kEiu8NLGLjQUR3aFuV
-snCYkP2uUT7DWjlcWBvCUUOJajqMNt5lkkTgceY95wln+KfCD4Nnr7E/qjOCXxGa
-4s+UuDQjhXotQufC9S8f+0K6M4zzTplxn+14rQG9DYjHeiFEAdFWXS01reYD6NFu
-dptMo0WIavVVlGIcW8C22lZ/RF8Eu6+hl5Byiu0h/ubJm4/2jqG67QfxdZT815PP
-JKUD/SrTjQUGqn5qXDe/raz5vGNjPX9fOISpPbalZU3MWmDnOT9ssWp/ONaZbeDr
-jLn8UDtv06AftSi1SLs1p2OctovGIMXxV8Ulr3gbXJpEiQIcBBMBCgAGBQJWUyYE
-AAoJEERFxmX/rZrgymQP/2WNkpHtOQcoFu2h8TVmWbXX11YhRnGc50U6y2S2Dthy
-SzyKc/aHZaG9l3jBOI4hepq6zEIIZKe+Z6hh2Pfb7k6JSRJWI5wKVOV1oUef8IIw
-wFpi/t+guuJV/gfXoWssN0idExMfMwSu7eR2fwG3y7Aprre+gxjxzSzICX4uul7D
-2la2soIO/NNVwzRU+UdlNE/YI8ux8wAtpITO6ju8z9bQf99bTawr8g5PQWkD83Wg
-+vazOgX+jSXI6QrG39DpmjbBJRrjGlnazpDHxaMVtw+ZBU596Xy3aqjFrU9jRx7P
-7iA5rvQUXUl2jxmPqTwf08kkhLdqXjGtbfn5FQMy8tzne38qMhGzGkNo4ttjMqzq
-L4n1Fs7DFBqcrxV7Jz/iNeYK2MZTtc4SWcVo5T0nMUxsvtZkgjx21L0MRffugG7q
-BBDMh1tV0OONbeCazbs6FUXJaIdOs9Bf2x5M1Nj0QNUZ7LHfNKB8rP4SI5WwA6Oy
-u2P+mfVl8/wfKnWjUmQSpy5PjBOzgwIo2TfFaCemwCuHhRDHQcf2GYctMJMX2ldT
-kNaSyxN8Q/7sPgHZrhOSeoTNVvHSUZrS4i+9Jp5DP9zOjrvrSvT48jbDoWyj9ivL
-EX7pu8pNwy1CpiMzXl0kmSg7OvfQ3lJ9rElQulSnyZxIOomRBZ1oVaZ6MHL9rQUF
-iF4EEBEIAAYFAlZVZjYACgkQIW1+kuthgZ6TBgD/SyCnu3q2RXFdJpkEuO3mixzy
-A6iqoZotr8QAjM0KcXQBALwzssb0CvXkzjoDSZ/r7baIhxoIj3E4IgU2o0wGbfie
-iQIcBBMBCAAGBQJWXY6UAAoJEJwYe6KbIVf4E28P/0AAki0xctJ18S4wNULCwZep
-JgmOorXYhjVN5KPfL8LQiut7MUCUewZVNibP3+lFTgLzytmucH8/5oUXHezgowXO
-X8+BI7llqH5gumD7Mk7KQJMzRXQIlo9tDof+xI020ElG9kdhd9cveKVlQiNYj4cG
-LGk+vc3OkB+xecRuWEqbmT0LJi1+dGBprLe84eKC+qOnRjYjKSs85hKiovNP+Ref
-Pe00p/wBSc/MDImp7UjIWkUdGWG9fq/Q5GzsJI0nU4DGP/+dGRBdlTg0qxpGZ0C5
-5igVQtyym728iVh3r4Qrvkr7HD8MAKE7eHgYriIPtB9amOFkBnTk0oa5qUHemzEQ
-Gnf3u3Tunc7SZt6JO95nbiEgNEWwfYQ4H+Y5xdFZCHnpUNSJdXxn4sFGafjSF1W5
-i7iYhpX8c2JI+hvQ1og0R+2A83NqYo/W6Q8fdS6eSHLgb4x0M49VAk7eWf1FHDRt
-K3cVKixuhIW94lWunJRclLyOj+z+cLNnZMz97c4oExSoCPKuCKPBGZZiK0NYTLl+
-Avezw+TDxic8+3GYnb4OSyAQAoOToy3WVBobsQD14yyw2sB81CtdxCStqXtkxy3q
-Zt310IRcAE2J+nwznc2SMIX9PjUo/cYCrln6KPg+dcf5Lbpn93iQS0+FhGnuHEnd
-WuObKPSQXM1TY+EK7PLOiF4EEBEIAAYFAlZetxcACgkQHCydKnVk3J/8dQEAhp4m
-drla67JaswrFLUIPZVEn8+mE4KAA7ej9iHM4LfMA/1t5129hEfvjJRrEbcJr7XNK
-E5a6N5JAGkoMHhtXf9mhiQIcBBABAgAGBQJWXszMAAoJEESE9VUtHou0QiUQALrh
-hTxmMx7ZKvvYB06m/Cb1PKxhn9xCUXQyCvuRNQmv0GXavWU7nC1bvzt2+3nbMboD
-343l1SvzNRzvSHCZg9NM50d9y9GMDWX2liB015coM6hmZMdU5SGYvqe+HLpyq2XE
-ArE5ndhsFbRb3UtZZXIwCwSbEOohk5cgIkz861UbBfMbRpk8cVV6I8UvSYuHKysc
-lVq3+I9SgfMIbPOfNG9T1C6/u+Q0qEIuFlbyKGu2o3EOCvMbfoCYcjVLObvJRKI2
-GtfE0M57GBbPKSoXbAT5WMytC9ql2kxl/B+fD1Owq7n88rkgC4VeUltmYGh8pdQ6
-G/hkpLQEUjbnveQRHRbrAniZBaz6Ujen90Q6ISbzuDZuZBSyXsIXqf2ALbY6ynbS
-jyluzXkofQg459s+FkMlypnOJMdMrrJtMsJyLucD3tY1G45epLtBYQhZerife644
-yxf5K07a4iqs2F/Zj+hqdcXEPV71MKuE15gZS7h3/QEEKnNHvzOSQkv6KgDIfCT9
-IJtD0QG7UVoMvQZUFHQeQN49bXUjML6c7mhNAgGCDAeI6s5KzW3wwYSvSCtF2liy
-1WR10loXVXIO3flu9G/OzxMjdgIPLobq+7Hv3NaxPiHwfmhmONTXuWOT7qRvIAd+
-dYnBW87D++jj7tSS3XNltsGEoErI4xznUQcVBbSdiQEcBBABCgAGBQJWXurfAAoJ
-EEcZ5PweFskwtgQH/1ZBH38wrP5Cjrrf83l0FImlxML27kQFBQ+EmXXE/DC2XkDg
-lHE6cIOM+PB0Ibp+mCN+7F3SS4kWQe+wwLxWgaWon0PzZmbuIHbZU7IgeUspu3q5
-945YRqbVC6O7K6bsZiofaegOSeCJmkXvieI5aMoqJmBP7RQifCxXCjH+5NwLMhNN
-ZIUHKscNDf8AcyJMrlkoceE6wtHYj7qgO9raq/Sqcf8DvpDnqcxOdDaHJIbiA2Ok
-78OPwR71NyoUAtC/SwhLzBiALiK6oGItaV3mkMvZRXw55xVehSo1NNdkRS/DjegR
-Wvfxm2hMZ+wV3XS+lGKMDsHqUkMDRIFXL50OZUCJAhwEEAEIAAYFAlZfLwgACgkQ
-bGWA53vXVsRAaA//Z9YiXWfY/VQuqNDuRU/9kaRHp6TqyGNEnspSB4vAuVGWuQVQ
-taji0Vbu7spakvLDG/jwWx3757V5jTlfa5v3Y8l9ieDFdQLrSUjqTYvBkKGXHmrW
-DDsj9j3PN2tNc6wC4qucGM7KkuMHo8ikfvoWsik+sx3y5SZPwYehQd1An4XjqQND
-K2IYnVbpZbj34xiz3EiaKhMLbWgSXstFhXIxPoEOeZJCDM3qnPKDS7+lkayDQ/WV
-+s41WkZN3N4stHnIRDl03v3hksXpv7Q0uG3GYvEKDZA1bBfGgjkrzaLc3JboQtON
-6pgm/jkLx2Goruam+m/O98glRXVO9xOFAYfUvmxP0NBLNocuoG1ZpQQasYmG9pa5
-C6wrKY1h3YCm6/87U7LPLdkfkJuSH5o5MasVkInN/Iz6yjqkdD+PK4k/JnrsEKpG
-3rx7vI17sCTxGTfd+uoADlXAax8xwNTfeVxRhaq20I+fq2H8Z9rnn39BHCp92cDK
-i/Q4VHPKNEuPQgJg5VdT/lzvfaZjakE8hym5KUKo/ArNR76zwqD6j1DgmDpL7uEd
-7a1K2BzjJC4nnHFjfJinN/enosWdgKhYUGE6sT6ggpbDVFQCxujpgBVn6Xc9jshd
-ksP37YrrhoRDiU40nGxmJsM42cPJr07ZZAghx1UDcGE2cw2phnS0hX5sknyJAhwE
-EAEIAAYFAlZmqBsACgkQf2F1YXeXj3bJSBAAi0LAUXOVdLAnoctRIyFRabhxMoIp
-KywIbuE7hvROo0hbvFecZJk9iIIyvhoZR1HNFGKbOdYYWg6mbl6q2KumV3Oy0Q91
-ydeQr7s1gycodsJ3rUgvQGidiC1CajTWxchS0t2lrKTYnHTQ9mhTXb+B528Vm+MZ
-avajqv8gQDsl2AZF1XfG9o6HkcGXHvHirWC0MsedZaVXvKfqFSNN3JYBZT4BKoUM
-k3I8Tae2bHOVKEEEUje1NrGxg/xrIc0/7UAxccvblZkeezi4V9KNMuDX3/DydVEw
-TL1M8/mbYroYbxR1rhOdEWlTP4nWLNLsOmHCuvRfwwia+vrMFJbBBWWfh/SJlSJB
-nP+n6tqeIIKCl7eAYYLcOReDO7U7ZMdgPqGr5fZdaR6+rIuzfdwIorjKQWxyskgD
-GeWzoSFu1KVdyOS0+c0jbja5HGcyTQPE+8RdLuLjk0in9xn1yMhuLOfH6mx7plA8
-e8xpxU5BnVgduI6BJhV4cqRhh5zl87dhzRh0EV6cxEF5XdS2gmj8aw7OaFbb/da7
-5hRGfT3eRFkIiDxMKyFmvpwgUbkzkFFU+88ZViM987mp+2guSvvEQKqIba8qOVuj
-gNSL2EBYug3HpWSjsLMS6Mz6HXsHMvx8IdXH6xpt4nOFEmOJmJRgLitAFwpIJ4R5
-WYXSTtC/U+v9/XeJAhwEEAEIAAYFAlcEtfsACgkQR3EhcfLtYvscDw/7BREMLSbp
-8OF6cFWd0/4fj3kEYjTaq+ZdsV8wp9lE6OxryoCD1iE2hOqQOaWAqx3jI8x/eaEJ
-fqZtHxwtNndCSma5ZWzMKL8hvJI5cJGRINiLF/04V1yqhWsuOsIzNZCyv8lQBQEb
-B8zB5uYmbof4ayLW+Zz9nyvg9f6T8HBt8iJ1x1zbmwM9mCCLpk+DYtDRCYCMXrYw
-HrV7fxkWX8Kinm9prSJ8ctqMsu+a17XIJCp3flgsWKzIJPHogaqZWHyRJ3uuJ0aw
-zZ52k//dLIp360ElcqRhT2IA8sFdcd6CElRbe5ZmHkTU8lYwh046V7b133kusKCI
-vd1wVgqMPBzN+Yd781QL9BkxgK6ta1xlw/q8/D9ZyUbvBu61eX+e7QrSpNyyFpoO
-pSRTfkbNcUIYFUpsCRVFedbvw87PFXC4c3UDSMkU8DkHlKSq1LsfjPaFjGJ1uftW
-OPX/v2l2B/bEYnu0orLJTD86YnagHIWxjDye1WpdQLkHd0stCX7Ohp3QD2u8mi52
-xU+GTSgQOaymHomKMx0YlOouBXqJdebQ8us/AmVrpWtcOzVPtYK8W6pn4S3k/S6Y
-C2v/ZHTgFkdEpz1Ffo16WAm3+xbpAEqmjh62QLWz2K2UsBeZFfzsrpGBG27jPSYQ
-EZvvfsettiH4HOpcg0izBqio+VdyIYmi256JAhwEEwEKAAYFAldcIfEACgkQLf9S
-a7F/dsahjhAAjc+hNnZSmva927b1ctGVPESgaiXy5FOwq56zwYtHSR350ioVR2xx
-QRApSZYKcwU9Ol/1Xz6EfVQ/pnsr4QUulAobLxFWKNpKhHzAIHocOUaynVTJm0An
-LD+As4IYA4B465vmCExY4kDgGjRKuUc/hk/Yj/6mPtxTT0de+CfbDNvij/1J/WVo
-04pKkjaPJ4D9fXijKuVgZjRineP2NrMnvqdF7ERqCOOFp0ITIyFhDk8ueBrQ3SDK
-iZLUfTwSO/HMMBY0i514kZDFxzO+f2xorOCpO5SEiYiSyxc2+fTLQi7g2pZ9PAGw
-D/XVIbDZz6W+2k03scBx1Kj8D/p9UjejWFyLrsvSihx1/pZL7ZZMqsrPbz9Wkxzv
-SupP5q4z60ZHYI8sewG1cWbw/2/QPj0WdKurYVWgsHFTl+OovSPTbA98NAqGlgkA
-avUvLUyBdergkRRsqj3LLrU+6QDsfR1ZxIMZ4WS0nJmMeGHn3G2wkVe25/sz5+Ub
-O2cmLomgFszVYJiX0EyIPAk6JdIRnfSbgtncT3sLQ1YwWJH1FD58SjIaseubSlal
-qyzj6erCmL75F2GLZ+pIwPhTAeRE2d0VC3ynQeg0NPCeRcMZwvHAWceVulGBtPCz
-08onYY+QLNtx6uF72qT4KW9q4LAZ8jIQ+qsPM5omfi8XFZ+rHFf1IX+IXgQQEQgA
-BgUCVmDKAgAKCRCkKJjHS/FV2/FyAP977D4KLKBG0kYXrvZRL1axQvvtiwOk6eBf
-tNcqjTqKYAD+JOHnWPXAZdO9d1VyIB5xTVtgY+gbuJigBCcox4titDqJAjMEEAEI
-AB0WIQSCcyDLXoR8kWz0nQYUdHOALzX2ywUCW5hQMgAKCRAUdHOALzX2y0GFD/0e
-e8e84z5I9eajS10MwOiQ7DaS4Pyy8lIRgN5Y/DkTJw2X8pNFYTQp/xCyems1JEv8
-v9dwPVp3gjsqNfoENRzIbSJ6oqnX+EFV5PS6UyXIwvDg696L1qcjCqbbcVaHH81N
-SredsArwKqzZXBzxenoHWBTBsVfH84h0XgvaiJ8ZxQKav/mRyxpn8MGjKVA7kP1G
-4c6IfVIaWzLc4c7jy3E8t7bh/uSaDDXKvIsEsGer5VM29fMNBVA5rIlgFkyroi/l
-5B3n09k2yjGjuovYzwWuP12Re8/032v6bCKKAhts+6ebraSQOd3Bo4Pgb29FwKNp
-OJbidPovx4oC9G8GEQOQXpoF9JTiKNIQtpZIY4lqnP1OOXiCw4gy9+HffPvkEvE/
-hMLdsoqJaDDsTSF8SKrPyakAWdv8XYXxXGiBfbUgidRJYJXA5GG9N53CXK+TBf7v
-eLNiSgAKcdXzxFUvqwyDdXfQEE1TbGb0tMypH8xlnXfr8xVyg7f8cXCGza4Y3P0T
-4lzxOq9+apZ/hQ0+Ai1W7yOVWLtq9+jGKbclxxIOlKoXKRkCBPXKdERQN8Zh29ZX
-EiM2IKGb3vWxRfjmLm12Am0mNr2eSNqbmplJMbqAi6eZKM12xuAqt4h948NjzEv7
-CKYTw3/OE7dE61SNxs+16IZ/kK0o+eCcYx7ko/SUiIkCMwQQAQgAHRYhBLz03kRI
-xxxGkAUXkozptKcsbOJCBQJbmFCCAAoJEIzptKcsbOJC6LAQAMgoRKMcQ+2dCxzn
-HVdxCzrxt7X3FkEyFfa8oPTkahwp1FFvJnueZtnS4DjuQH2iWDVSfl2XlexK9AiH
-Q534GrKCPxI0Tl/5BCiUSIIEXBH/wyKCzNhk6zLRWgOmOJRwmPhVHYdH52/b1Bfu
-deF7xRflsxC/fxnUJUiosDia2NFOu+PQDfdRimFuNGilkR85Dvlr3XNpq1IKSV2N
-2wlijMSVqr1aQD3vtyIhHX7FXMugwJD8kpEHwpll66l909ycFT8K1/D5EW42JOlN
-OwJK124iVcHadQyJcsvjbUf1zKIlC9i/qLlrZsnY56zJ03nabDS5BoFkGJuah86q
-XlwLQyZPFs7mI4i2x2EYBh84PqsndykNCDE5Xfbcwequ3v2DLUwlwbF0DyJEkItI
-GGI+4/v+UDcjo9OhtaSv0p8/bpNYgueC8YNwxx0UoOInVbG9iJV9oNaJXIO/vpyr
-6j71/JLAsuWR3pluly38SO0MOQlecFHNgtD7vgBxZgzr9yuBSj0vnftAf/ec0EwQ
-xNm/YTfexJ0lokr7V/Q5zmTMgBDsHXREckJ/pvEy213EsKN2ouc+FVAWP6/9oMrv
-ivi9lhYaRnVuZCIYDY7DWMIig/j+XsNxadz2bajWsXqnBkTSBBsvNZEMosUSWQCU
-Y9/txLVikEmlq71emzjJq2tI7GAwiQIzBBABCAAdFiEEdTtuzytFj/PRnVaMHgoo
-g5euc54FAlx4SwcACgkQHgoog5euc54wnhAAh6GaynWpZ8zmoxKggSZf48T4rRfY
-b0mS8o5BVIOud3x3qbggT4XTP57DW+fK+IWDOuNM/FODLcYgqjkWD2uaTrPZs9dx
-y3XYp1b9MmVwWvZNpZnBDR3KXUWwh0Q7/3JAPyVVSMmi72IhtYJQ6sPZ0kRHl4iQ
-bUIi+qChi7dIClA+OXuQqdBVZiECSubRE+cPqkH8Ju756O44N/8aHODoU0JZUl7W
-aLSPdvDSe3Yz98Oa55wXHBaJZSwdJwevkcuqPnuiWPFriabqPlZ3W9jYtsnDli0P
-fZaFjnandstIKXRVpXpU759HA7ra8ZtFlsodBhr5y2arFbD1rgiOZ/Z/mBXhuIeu
-ekzWHfWrN15M/yoVdRjgDiacqJMdrO0IGD72FLKKqQIukqGumJsdKEDHAdM7uxkQ
-cf9X2r7zO5+zKDCwJECftkflAiaNFrcabm0j2ohKuyKek8t1+ILVbL7BcKI5+Deh
-bncbkmj/mWRcFSmG6DCI0bqZJ+NilV6L/emnHfmAra37AbZQGQAX4BN3oalMuISW
-wBpwD38x+8wrzNFmuUEd7smv6Eb+KvFYSn2nADLEOUMsKdP3NjPkrORRarQ+X6BS
-BmSDDea4njRKKoN4rNKqRHR+J1oFOsdMg+KcwLP+Nw0TlDbyhYKvgiPvPob8Lfaa
-i0MkPPH6S9EZBL2IdQQQFggAHRYhBNrbzFC8IZd33FUftS863I81rY1nBQJceEwV
-AAoJEC863I81rY1nKIUA/02yXPg8PWlLz17fCbrA2LadN+PIGjfmNcwBZ7ckbgH8
-APsF7xiKbRx6hTnCdue6i/rCTMiFQdLInaYwom5fuZNzDYkCagQTAQgAVBYhBJOG
-ovstqdDTH68IGMDAdhMv+naVBQJceV17NhpodHRwczovL2pvbmF0aGFuY3Jvc3Mu
-Y29tL0MwQzA3NjEzMkZGQTc2OTUucG9saWN5LnR4dAAKCRDAwHYTL/p2lalLD/47
-1s6Uw9daxSJ6L9KmgNRSfjX2k0xIdU2+4z+LxLSP2+bqL/sw9yTlJ6QwKD7kt5On
-iv8M+EpK1TNLB+30X2aLaBnwjlwlkkggQhvZ7cQyMyXeBGxxFyYIiitLQWe7AWJ2
-SSKjBH+m9bcwmxDxTunPnW8wo4ZwERE84PhqPzYnYyb5la/f/1aAI7yIZBKzb7yW
-K8jUrP0ZPHG/lyg20UYEEsKEdDTPSvj8K8jNlKrJ8gzNwa9CXCckaIhSkf1ahP0v
-03mvuK/hU5t1K1VYnaTPnmRHtkNK8DYEN5Le+i1heH54NiOj/9XDJE0DfmTjeJ5j
-dCCNqwpKtpf1QtZ38Hdl64z+NJx15kc1VLViLBb1A9bcsFlqpGaLda/fz8QZhBK5
-7LUI8ppJACXYEQY9xBzWJt7lmOZwUPzW0VZnGaiF6eb2yK8WGCcvPHoeEIiNeGua
-b39JV2rlS7nQk40TJONbnlpOOpjMg1fUtqJwjr2DFoVStwvbE8cCgywdYss14Psv
-dFMIfQc3TLKpbimFPNwbSSs+YNaNoICSMQRoBaoNh9Va/9yicK+my8ke1keyiY2A
-qj8xQqy78Bm3y5prMKu2hX9vxqz2qspvlGtbBXPdq3BIvhKy7FHOdyuvpdlTSh4h
-zMhS0w2mE1aKd20Ym75bjUwC0YraqZtpkA0WAxXP24kCMwQQAQoAHRYhBLVySmUK
-TWdl3EAaq6fCR7TtCv/+BQJcyuKzAAoJEKfCR7TtCv/+tsAP/1jG0mJun0X4H7oF
-6WzFw60d1fs0MnliPf0IOQ4c+57jZ+fMP2fKwGA515pHH9u9ZdZtORlq/VyUcqfN
-sVkk6HYjPfj2dpqt6pGCBBCJD3Sff/ktgoUpR9MLLtLZXtr0SSe1Wv/8bP0csyzz
-yORFEFCGIIhLPyeaTssWUVS4/YXBSUIvcVohNTh718BBMGmFoi/R/aRIosO9CBH2
-O3F51/HjsHsYYVQD4JfYaWnytWepFYrLGQc68/Ncnp4oRCVGgjG2/ABeolXtNArT
-JqOb7sOAeQOnf+9qRad4fxMVUbH5zDld67riDQBJETsCjjMA0q180Kg5FV6zDrm+
-qHrty27SvoBxG1cHYqI/KKcnMahYkFXK8bobZDHoMe+P1bXmz9NV8qhTyc/MmKIl
-sqcN2G4gE51sUaRma+kHsINkDF+j40ljHPQ5gj/oT/RRXOr9HuG8bEs3YZB3Csqr
-KcjyVzrw49uqpYYdXKP1NEfH+is67C5V+aH4siQjpjESGXA4Qe15kmNYIhVerd5k
-HTK1VrPi6qRK3N1XEHiv6s8yexv0mCw3iEx+CVHr49AsSgOqkUBVA/2lof8J1Jg4
-BrZIxmcosCdm2uRp9/cMxPcz+H2/IBvySuCEROKIReO6SZ5eEMY0AnUa+CTzTrHI
-pjLeOxKb9lVZpEuZSjMktd1HiYtsiQIzBBABCAAdFiEE2Ujwij4RlwTNtdJJbbTs
-FM+15E8FAlx4PfwACgkQbbTsFM+15E9NOw//SpAnpQxC5YxQK/5HxxHJ/UmawOWi
-2EHti3nqe6vFlsvzdKumKXNmtXCrdVDlqx3/XYMSp+7rFpI3gv2ArqSGkVYOjSIF
-SdhFl/1SUhsgYChM4Qq1YZCsoqe6TFsiY5qLCUjXP+npP0uI60PP2Cma9/N+EEgR
-KXkVGV5E3ptssP3uXJPQXR4nvPkTZDeyS2i398YHXG4mss1QHkCHKtCAHSX16yb3
-j9Q3mz9NMF8c7j/O2ev4C7mwSdTXU2g0NlQo3aoILUo9IcLvagCDmkWf95SnM/sm
-aNOYF8ykDh1apbagnY1zrt/cuDZZZTuyU9Vnf4keuKPqXY3f9fBx3Ln0a9pCI4dW
-PnQVDVrPUSdDIwV0mTNw4Sb3kBzVl83p643+8ytW6lTw8YRaCUKdpa709Fez34TU
-NmYt530hvMK2tKoQhAf4TvzFPBtIHijVpVsiRnJiq0mzxAGGWBpDmfFgoD2spQ5t
-K4u/z/N9MtNw5BF+rCGWKAFt8W0yS7Kao9nGZv30gvon/NDJ+2ujJmXydqYmSWUI
-sbkrdeJfsipMY2D1BTYMtrv3XzdADXyZ41akCtxHoF36AjVUzmDonF3t1Jhy8ODj
-43d8JNqdYo47AjwELa1nuaxC6sNzRKGaasQNkeTYgQnipgzwenVi3bjZOvDqz9II
-+LlZfl9RgFVe7Z6JATMEEwEIAB0WIQTpSKVTRkAB0mheRZfLcHlIHMYLOgUCXHu1
-WgAKCRDLcHlIHMYLOrg4B/9xbPN9Gqx+l/ZTyGjYaV/4/xsDFC3sH+cRj2/WCdzJ
-BB4lldIfELt1LzFKvGI4iZf+EZgt2ZPrJbVzyK51FFNkkJJ+CRjjyb8vHsE4dBcv
-xPux5sJRIo/5IOqBPxc/XXt+UbhLXTJ62Pa9SRkc81GnHYoAUr5l+lI+qUxYg482
-t9+XYbbUafys2tEh/UytdWT0/RWyfEio+i9AGAgqr86cHBKfUAztC9GC61GDON1x
-IVqOdqhw4a7Ir/D4YchJ3y0dIuEaxKblMalbMYTIwneXIkoQ5oxWdyGl+1cKqQDj
-WJszPId18BCrJRrLakNUW/FJYp0nTeju133GyX0p+GC8iHUEExYIAB0WIQT5BYSb
-vixHTPrtaXHQetLC110c9wUCXHvb5wAKCRDQetLC110c97k7AP48ltH1m0taf1vK
-Q41tLDeqZ/rhBMaIGAQihb5CUk824gEAnSlqxALq1Y5BejGiqpbC8gOul5ih03Q4
-mrP3T/IlGQWJAjMEEwEKAB0WIQRPn4n1UFrB0aJgYxzbEYe53V9pOwUCXHvb6wAK
-CRDbEYe53V9pO7AaD/45n+evO2Awc/U9Fr3CIyR+JIuwiztDpAriMI6zyr0KDMQM
-SIgO6RGF//0QYIUAQiCtDyaW6zeuK/n5kgz8TZw0tfVNc+0gmPt7da38tWCZxreP
-y53MZUUUUg1CuMmAmyb5SrvNYg7wb5UxVl7ZWhZlTl47A2J85bDmZsKL0q5BYFzo
-D/iAvM7LVJqLV9pjcFDzr8hlp72yjHSHIC7yWVbTGEeI9NpfGikBolyTIEzkVoIE
-4tkTcg2abETnYanZQEDsdgpb+3xI6oH5069AbrBoJIgxa0K4T3o1qfzrScDdGyVU
-3rJitB1TC/BCyUokmI8Sb1P/OiG8UxTBEjy8q1S45Cf+Ml/aZmFZdsioCUh5AlCU
-5xI0oSiGlHImF+7sKWXUgwAaopDZqYHm35nJfm4kW0RAgBzwEL3Tt3iwm/n3Seif
-0tuTfFZziHe6B3dWlht/WrNhcJEX4OhInYWIZ4PrzUkNfVOCQ/R+RnKSxjHQSldz
-VNGvw7lWNw/Ae14BgioO3jvVsU0z6hFkdVtjQr4ejY49XiZuErZxRnqcmFa0PTaa
-KueBSrlbFmre8bU7uIkreWP3vlcGIDCBi4V9oN2MiX93U73vaSVb7uxgNDu/6j9Q
-sbHqSke4oRXEbUqmJ4gMslLieUJ6l4jobznuuY/btMy/mF/Hcqfi0ocjG+yNJLQk
-RnJlZHJpayBSb3ViZXJ0IDxyb3ViZXJ0QGdvb2dsZS5jb20+iQI3BBMBCAAhBQJW
-SIk5AhsDBQsJCAcDBRUKCQgLBRYCAwEAAh4BAheAAAoJEJtDKyfRuiDX/38P/2/z
-ksU/2Vmcsf4BOS0lbTMjrg1T+PYBrGcXRcmg8prDUEOPDMobLEgHNlvImCo6mt6D
-2fjTOs5v9IatzuOM1B6c+fasEFtQoP9jsU59/y0QV7Vy3iz1n16sBjZ1iL68lTWs
-qnfSQMmRjnn8sXJdA3R8cTx7p5NYE9qyn5WR9K+v8nWDwPpfG0nbu8LrY1Jio1Gl
-ZX0JWFxX5Vq72id8k48IHCG7+7pIpSBak0Ot1TDYSpZgrPFbmpR7oPPLh8/V3Pqv
-CRnxHgLYyDSX/XLJwAsYzz3lHsieWF5cnk5dhVcgCrum4OLhz+OVTUmVTj3yRZat
-ibUCknk6+QJK/0NTfH43K2hNGJUKPk+B50ZkjqZfYWwfW0KBd60AGesITvP4S4P9
-DAGNPYyhcvr2wrN/4DGnB0Z3/V52q2suz9SEWHBDBBfATmDQ0M6YZ8WwRJOl6vaO
-KFeWHIaOsSQfI/QdjNjt74RPqf5E70uAal//gQCoS97rF/3dkpdssTZCO69fploQ
-YycqWpEA2O7iPmhopQSuZy9K35JbSpqVyNZmomi1vGV2npBQauD4QnI8g6QkREI/
-VcWgsYmQjIUPXNp7K7JstbDkLyUdssmiMBOQZnFErKjJQ93wcO16lTE/5kQavdXU
-ZXhqnBgY7FZrPWBxEJte8yoAgx4KGybYgi5Iy/TPiJwEEwEIAAYFAlZIiiYACgkQ
-xEq4uFAyffmvSwP+LVjKSDkYwmDOMpsXqc+zTqxWyKU0cVH/T5wavNn2o+7V5ILa
-Ko9rCkORrnZqv7Q7W6JbRdnci4NPOoD3ACsIsCda38lrbvFHucahxFZXq2NedAuz
-ZVDZmFLiNPDJpQBAci9NFG/PbtQnRbEwawLGBB1SVzmXWyaQtbpB4mxyhviJAhwE
-EgEKAAYFAlZQ7FEACgkQgNiLItQzAzGOkhAAoouvlNPAIbeP3iw3Y3M1yzpTfhjl
-hcAJWaWbEbQeok5RuKjsjK3DAcQ/5iAX/BMXRMoQE/O66+WDrTKI7rnOsr5BhMKU
-W5Pk2h2Qo087LpVeyov2eM8FsNNb9yF9Z2t7Arm1bkN2GSZrkaES01HAc6kfCOm3
-QJlX7U5M8z+236MWTnMRVdSmE1GCLa6k9xvEYjZukRWy5eNSAmZxCrGXrg4QKc8a
-nPHGhtEWrO5HNBShTazaM5oqCEjsxr1Yt9buPp0n9PUPd2VX7b7KxgeKYDtkwXPj
-7eqsks1PabBZQrbtT3sh+DPjCR6ar1nR3+ADE0g4km7bNWZW25JVj0exsy8vuGJ0
-MWSWPCTaVO7RsOFHCpDZaKqH8JrtjJK8kmLJMIQJzfEyewnn6e0ZJESyTpcVEpPa
-oJd11xx8yl9PDU/75X1tRjs1uzx4zn0k+XrT+ETDkOJQe4sW6RtgBbspAEX/16XE
-7gHiFpnw+hn2ZW8SP35zyERAQFZvHbu3sCGJ+S+sPLUkJSzMCeQSwiD2GT+EV8IF
-8T63ZqnqPuOXqlycAZhLZabFtRQ5+SjdVs9WpUgXPSVJ+9xAmmQva+tMeUf76D9i
-fCx4AshD3UgEyr8sd3i6LFpjILQ9P+7mMTGcnY4j1p0QVIFF6XOj80L/9jVGFVkK
-wTajmkmRDBU7GhSIXgQQEQgABgUCVlLOagAKCRDBkcHuDe0z6kKfAPwIfmy5ZTky
-AbT3c6xsAhL14KPYgrGVLRWy+niKm3+ATwD7B5TqgHT9pCEHvzN8xthIxYxFHahq
-e+b/PeUEvhtpkPuJAhwEEwEKAAYFAlZTH/EACgkQbf0qziEbqtA4aw//YTk9Q4oM
-pA/TzvVdlyCG1EDXsAaI1zHOWI07y77IGccddDuk+gszwzfT0f5g1KdKbQHxAcPT
-a6m9I9OvWJgDgF1lPC8poh/9/j6rWdTkl5AB2dTtttA0uEKoVQhz+pAo3juN1aZ6
-UZenRa5gXDU2bpVngHXAr36mIIXX8FyGl1BlpczKJmjt/OWpPC+DAaHyNAolBCIe
-JpLmSTfenxmfFjRqdY3cPFTIAfPlNqIl/cw6fLlpiQmBFIWK5fXCqMi5cxO1KuFs
-lqIIUa98KXO4Hirr9cLg7rXR0rO0dKqK3YEzpF/Gr/MP5Hl4WRlbDh1E8PW7Nupy
-SW07Iwq3WSNGIY+irtbKoDfsYs5zFxVAFrv/y6zfipjhV1GqIgd3Lo6zLGes1ZYL
-rvMSjfeteGIfnZ/+JSbxh3cJx/vccAZMrphFQOcebDYsas1Jr2Mw2ctr02kL93J/
-e4fGHMEJG33XV2sY95L5VIKuUq69+uGuAn3qUFo+9Ec1hd5W3w6Qau+bHN0dnoAm
-Y5MtczxqkycXrVZfb/JXUxOYcbtaByjcVfSPuhLqDQJh2zPUWtvxddNw9R9Aaqd6
-olMjAV8hq/W1OhXlsgR+OPbEvXhEcMMJtbCtGNKIZ7cbNVi38SC+rFXsvgmrsXPk
-KJ2qufu6g6Z0w/3UUgWoot/DeZc7goSOot2JAhwEEwEKAAYFAlZTJgQACgkQREXG
-Zf+tmuDQ0g/9Hl4bXqQsah+L7NKiMsd3avAtcGyodeDigaeZ05gc5kiSwHPXi3K0
-SWAzWnhFTZEzLgGHILMRm5EGcp5Kaxd+MYFSl6zuHQG1CWMvHadFKdJaltMp2Zng
-4dUzt0OtgG8dHHPTRCYX5d7EInQByHp6m/kM7kRQQSxP/hFgvoiQODeWfVZEmY27
-sztg4SPFLg0ugZmNgsGMeTa8RorSOUtZL++esWmdJQSSHZKWq2ILMyBfORVuskO9
-AJpm9ElOCDKAtyQ2/LADQOK0Sm+I/TXIWQmpeL60i3Ik7p7Qms7qZ9HIVlfkrMyp
-Lpjn5RX7OF2KICh2Tr5s4EHTXGyaul5UQaDm5ZqW6xRBXd0IwHAaRitMJz/fYQCe
-X3V6LZT9oM+C1Z1UqE+z70Kl4FuuwrjYSsLeR05B7oaaXkvkiKk9uXWuMt53VV5D
-h0BQbih3IVAZFJxMgGt2PO/E8O7T8cD5Ozsu5g7OIew+bKv0V24CstwXq+dkGD87
-Zwa1wxU5UY6FIaNiOoC9tmxhTlNaA1amcRcmf+jsM6i3tEgLf/GRB/TzjtT5L52j
-jk/QLsEI+ZPZ5nPfc+H5ZLvo0UyC8fQY8IGR55PrrFbcGAXK91FubHoENiXMz1y7
-syZB9swtC8yUz2nB6MJiaEApEZvIAZfKsJOhTVYeTf24kHquksiFvoeIXgQQEQgA
-BgUCVlVmNgAKCRAhbX6S62GBnrVjAP9r92ru0lzxBPiVTJfUdLI7BaLkSRzvLxPo
-mlbox+0FQAEAv7hhYHviW5WRBuXHIv/CQRssuxse/ZxUEd8ibyyz9NGJAhwEEwEI
-AAYFAlZdjpoACgkQnBh7opshV/h+Og/+JYbMQuDnKYuIng9GIKNOwXhEjwMdbV50
-Y/o26aMvJEeC3nYZkyBU25VGZPmdqDVXQ0ZtqFrWQETGhkuFDZKjULyG2HiwokDe
-B/D9w/S6CCAUSET7/7DoCRPGzbyjIfWnUVtZCf8ZPJO2cpqsvFbNtIW+ta5phLbx
-+fEhjQTbj9PX9BthmyYEMl5lu4yloOUijHSFLkoO3yj/76rUXmFNrI43szM+cIDD
-ELtNHVcLNfOUAwQ3aq4TTLafO19Tnf0pbjiIdurws9kvHavD2ROOnqgjMWX4auzi
-HxlB2ivzp/U6vBh6wHrlWtDWWO8omiZ1V4/+n80UtwMCgpksPKhn9wViy04FHBRC
-N8NUTTwM3T6qK2Eco1CL8Voryk+QcvZktZJ/Do1CmIZIs2UapdVS3Q56Z7++yHKd
-RhttBzCzc+VGH1b5G8yTX7Hp+aa2yFTN2aE79ELOwqkaKpY3WSLnLdKS3b0MGkMw
-ijNPgi2Q9GzR0n7hjGklcnsZosnga1lSAMAjTGxJvH+fs4II1vOj5Hj1+bxGds1r
-6E6Iez1I74zZZFOHFfR3hh1r7h21/sMkWOP9s44/TREX7Pg4rywwl7iL2Q35VWfe
-zvamnrFn/sbD5aDij7cjo9AM9tGE1TM+JJEUhRoTrMIEiHM2cmtBCTQeVyjrifW3
-hVkv4OcYQpuIXgQQEQgABgUCVl63GgAKCRAcLJ0qdWTcnyvJAP9zpjevsS/CeXBA
-m4/aA21BlRxMxu5ayvWs701+XkOQxgD+Kx8RRTvVM9Gju1XIsdPzbYelpkaZuWI1
-8nFz7rnJIGeJAhwEEAECAAYFAlZezMwACgkQRIT1VS0ei7QM2BAAkEuNCRmNcoCZ
-sh6N0gNe5RdU8h+z1iPwZ12a309AnUbRZ1P6MKGzmNenU0F0gnM/QHfVcNUhY5+E
-NdxGadJ1tOWUGA8+FIw+mCQoBnwvr8gM5VdaecNmOTv3W/lYYgNZS1WFMSMMUtnc
-IvRZzKTpoCk/OwNKt/28f7vUpBuTENR6ynxpQFTiykUE9Vay9P5kXNBwHqI2urMM
-ci7WrDWrqJ1pa4SRoVWWiPeqGcEq5/HHSK9L4JRojNs7cZur70WZqNMpdwV5Qy/d
-Rrv9t8T6tAhV/sb2bl8ebXJmb7/UWHcg8ER/0RLXpuD034hwmiS7PD6lNDPYi+hq
-JC5k/PqP9WZIxRv8ocMxsnrQu6opFkdmbLvfjhXgSQEUmyslcUx8QbCZeN0hQ+zF
-SFKrQOZEAPzPfK10CxESx2h11cqTr+ydDXwh6rPE0KvMiELQ6OqzMW22P92dvuf1
-0ZK4kvWwAfijLI4f6Y8JzbzjUOnJu/CWbifauGFgNJa4jiIxHBaHMrHxGKAWTUJC
-+hdGp1NWyDdnLrjfSI4s6haza2iGfaxK+jJ+WjTVT3E2rYrDqr8vz/uRiYWo/ode
-1aNTp0IQkWZzzbpnHeSI1a8A1s6nmm+8oreCbVG/7E2H8U8UAjBhsPvTLcbAHkeA
-eu+7pDhEoLBSUxmnpVbJYJPlsq90FDyJARwEEAEKAAYFAlZe6t8ACgkQRxnk/B4W
-yTCJhAgAp0TTNNbZfiIrr9xJOQqRa6hdOLearK69RiWdMcomBfHpK8+coXFIIuUt
-VBA0MZz1dmI72DFO7gIPfrQMNTbqqfVRdzt4KSIDRTqxMEnvIebT8E2JZBlfxU43
-0pLG0yOkyeLEPyKh6eUJtzLlxBH66EV1O0gniod9y3gox5XiZehVxsIhg+p7lK4B
-Q/Ivq77M6SgPWIeW2oWW59cK3JUxLD2RRTSGgFEZJv6fJjbis+7AzapsqHALcW/Z
-Hl4fZScs1oTlHtJvkfTPI7vh/EhgjVTvpWTeGsTKIy9PkASquLzSRdaI770spYFd
-7SAOoOyn4urIYGhP1N2M5nYhDGk6sIkCHAQQAQgABgUCVl8vCAAKCRBsZYDne9dW
-xKJcD/0TpbvYH/mn+6xKFYB74FSViY2nrVx4snS//rwaIwYvoexMjkznbq/09lRf
-R8iTxY7MtfFVd4KyvOWGQVdQXZYnXxDw3FLgsza2QNsuEg0L6SHEBIIJHdk52ykY
-4XMx0ER7tghbE6NDZdVaMKy1SO3u66beCIdsnlS7YogWZHpDzh6wCoWV5RKziGS/
-XlpvJs/JnEZWiNAcVyTtBebkrWAD//+nxFPszXj88JEcduiM9ak8id2UAemcWKCh
-akeF6QvfEb/nEh8lJ8tFCUazN2Otz6TqVtLFDEcV0epPD6SQApUnzjFtC/2/pqwU
-JldT4ZW5lmfDaeKcS8T169sYniqknr+kE6mD5Xh98tDnOzGHImT+oU8TzNkxUX67
-CWFhIdM4C2VLPTpWKhpyN4ax4ZHl520EN6Tg/GBk4n5BwIiJgdcjjAsaiAex7URN
-YDQv1XASgbG0lKiwwAq14pjX9M8+HEWkpuGxZw5Zb3yNAa2A3qr1oncwDLFeAxSP
-BvcVAGwuqrfUYP28ThRT+8nw9PDb8Y6IOqA7RZUwKN9M6cFshkiY1wRW6TfalFAD
-VMxtnXFrNCiI/oHR3Ll3Jsr6bRnx7Lg+N9dk9+Y0wNcP/zejjgWeDfk79jh0o+A3
-UREADrDXmybtT7hfHwfJF0lcaYF00rJfi2a/4hcbz/MnQVrOs4kCHAQQAQgABgUC
-VmaoGwAKCRB/YXVhd5ePdu9fD/wKvHbb8lhRO2ZtPVVCmW2nYfZhHNvDMY2Sq7jN
-9uo6f06cTN7ABpXppb6jdtmciqH7oYVVdoBPkBFoYd3X5WezmIkftlGPIQRjlKAY
-QF937paXcWZtgyLCbrMXD54HqMB5tBBevgsWF9BQIcRm6HVXszcRKNOUkkyBkbqs
-iWvLyFc7ihoqOZUf7hXfl0mvjWStX+pEaVZhdPBdjeHBjxalAk6aTvnl7Hl8TfGY
-OuZiyM+43BB3iB6ZAKNqcrGivrvbnNhEPGLycXat6alBD0hWoFNAuZgvGDJ47fCz
-qWVOb4IAlDNWfc9CY3sXev53Uyo1cNGeDdKTMZss9F0vDT03zamGvbt9nLJqu0yM
-VS+BD00jpEo1JxgGx+580fuNwI3PvMDmyShnsuNqWnWAJlz7PAbXhvB+u47dvscM
-zzkSdT+5O4vGiu6X44uOaicUy+pSwAPVdz1y4ToAF+sPCfIdm67d8IwwWDYCmnM2
-to+gjNlGtbGa0hxQ8VsD47+2xyYI9YRAUMGxvumwZZKqvAztVBziy6Dd7Fuspetx
-fo8GURoo7LKHHf3sOR3Uf1nqOR/yuPuV24AA1lCvGScThb07HHUvXEFZg34dGHsy
-9Dra26jB57jkORaG4R6UbpJYrMRLVzHDNGtlxJJMWeS8LmQvJU2Yd7PGK6FH+jsi
-J0jrOYkCHAQQAQgABgUCVwS1+wAKCRBHcSFx8u1i+whLD/9EMl8FYUuVBzquI1Z0
-3vtjX9gWwW+KoNxrwjFS/GRqVWymUufCdX73kxml44h6ATtu9lvPwd+vpzuD0e1M
-+QF5TL6SD1Wzrz8Ah5o1sKulsdMfr3APV+9n062xk2Fbya0ysLKTugWuFnLdBQ9x
-rNFbFyUd7DbndJYcfRjpRZMUrEUvWwbIRGSa++siKfvq+I4gwhLmfFTBbS80BBkW
-PQzfX/LHlwQ/5v0d3RWODQJNFlzJj/x9tWJ+/6BKmSDOD0W98WlAtXkLzE9VLT87
-HD3biA+PfpDmFr8mZpuUJ/fqek8L8vKgCod1UIexykEZdhaioHWk1ia+9SKMIr/X
-g6QjVF4hF2Qu3ZUut7cLLJ/Wrp1Hzw/PSJrSrX1Cytq1xvwRz1CO1xSezJZU9T+u
-ipIpB/+mNu7oF4w+w0XxJtTicu90Nb7XSMTKjYW3NVPwaWPyCXBUPZuGx+hOFYI6
-iKaAXQGTrAmAPnSfTD+OywLfOmiPhGtmhPaFCgNvR6ZzewouRoei/iY39OGa6xNv
-vL8911Wh3o31KBj+nTz4elSPOoN6/mtWLamWGcEbfyKHIUxz9R8S026154NDfLpK
-19QoCEbnjWkBqWdF1bDzwtMX24W0jx6qfpDtq50zi4fIDdGbg2cB3dFGMbGXdkj6
-MsLwIUHirXtJRmH8LwvTR4tUCIkCHAQTAQoABgUCV1wh8QAKCRAt/1JrsX92xvcz
-EACBYMjrkJhGP6i6xBHFy9tt7UVklj4b0Gxs8xQXQtKV54SxOSVPqZAj58/TzTtu
-XDtmskuafD4cLtne56c8B3I47tYcxVTxDo47cikmlLEXu+wiO0dArPXoDcZvlVe5
-M46WFYD5TjfOajelL4CzVZgd0hkQAvQnLFoLYlCU+D6rVLVLxt3uKg0HH0xYRffq
-RPdZoCrQhVDl2I/xyUtKBKWPvEszC/pTcRO1UgRgmJg3loF9JuYabdcA25Uyhwke
-ZtFyrVZk5y3DUCMf1XO8ieoQKOXlB5AsUAnuLljRg+xzc+V88X3vYEFA7+67sWzl
-vXQC6i3JEBScXYiCQIv0RpVHsvhl38YyY2L/UFmS4gMqwxK9Yat2jr3ZJBvSonXJ
-TL+HUjw1PwlJ2Zb8VKbOM9niR5mMOqcByaW+Qav3kAHMfXzz663ZpypGnbmDOXRH
-uBemcoOoi2OUOucGkcGOKqnNw8LL1Yqhk8LAXBZHkXOhTHZn5bph0hDOQWFU/+mo
-q3t0lKIOw+NuwZM5JS7Ezov17ckoj29ibNrsEOFe4o2psrb06kPKBODFMbiSz8W+
-7Ej44llvtR5F0HHPuMuMR0jpx7/C+CCFjTLNnm2OhnKmM51GkCoWEzgoIoDw8nvP
-wfyBFGKoCNDF+fo3D9gKmADaHXpgpThpm7kPQ+D/9C/96YheBBARCAAGBQJWYMoC
-AAoJEKQomMdL8VXbJLUA/36LP8fBE0g4u4eZaFZSRToSSwqtmTkOqMMiOBkdbqLM
-AQCffAR7mWvNTNkMUXHNwmPmYNFeBwt+sVibIOFPSeKYz4kCMwQQAQgAHRYhBIJz
-IMtehHyRbPSdBhR0c4AvNfbLBQJbmFAzAAoJEBR0c4AvNfbLRJcP/Ai7u/26j2nT
-zuGQRItP5u8wBNZQOgbJsimuYXhkMu2dw3YeS9Pgx6HxC8eN83D5inHK69sM4KwY
-3tS2a1vMugPd5WqZw2wmQl0GGqYChsbPYfbO9r+hOTgjm1w3ywz3+53xAgwQ8+tb
-IWCqoWPbdolVNqwU00LH48/zH1SK+XTyBilZ9r7XO1CQjueWAIngzz2qwA0WFLp+
-qZJUpgE8yT1lKjj8HBlI02yaeWCu9uhSWBg1Jv9xu8sxjTeRL9ykieH2znqNmZf9
-nURdWDNnmmzfxZjQ8rx0g62wD5r5xNLlKh1lVXIs5zTHc7H+JTYD2s47vBbMaPHP
-7f6ESuTi+kHF6mCWjY7lPdZecAfO9iI/8UjphCR33DQ5rCPkSXxCKyJrxayOOx9D
-EeSfz6l/f0S/irGaH4JLlxIPm2LD3hwJJs/9wEXja0AFkZP7iY5aeKbpor6xQI31
-2Acq89oqO0a1L+oGXLyFJwWKef/7VgVIj/SRNc1nB+a6UCxnzbEydadMO4PmiaTE
-M6tDDiY5s90NQzrx8/wV7BcKhYeJdpUgx7/7t2/UlTt/qB1t7jSWiRnJNsD+Jp8I
-NOec290zLMdrBQiLic4GGcIEtVDVxNzhD/yKlghV3leyFRdtHCMLn8Yq3vwJ3wmj
-oQIy27wZy5J7dus5V976VS7v3DlP90ukiQIzBBABCAAdFiEEvPTeREjHHEaQBReS
-jOm0pyxs4kIFAluYUIkACgkQjOm0pyxs4kL3bg//UVqY0LIyPULgWKnuue0l2FWS
-rXp30mqdGrjIjd/E8jVEvtzTAbJrou5KCBkzyYdMgZG+Sx2ifxM8vdT5Ul+v34Jk
-QhFlg5LOMKkMv/MVzq5nn1Vvm9Ln7G8LEiSb97Y13DygbiswYduAXXdP7oPezKbI
-3xgfpdAF/X58K/cEVMU/5hPntjlocURHSCEmEMxw6R/WxKAm//VbKqpI3RQadHF/
-ZSTdj/pAm3N3dj/uUcIEh2Et59lAcUwcznWdWCTJH8R0rowOxBlLlAlZdmfOSqAz
-JFl7XT5BI0UBJQaxNAfRkot4UQGS/Wu3t/fC5rh59B8GsL/WuZoCULhtzgn7+14B
-Uu0T3sa0rYoyMbteTQ6VJc74/oDXO3osKxd/u1LFgwgCGE0gwEEm3mTAVbZfxcIu
-yD46Gz+6qIojrLl3J8vJbiDJLpove65/uzwLSvEagle8Ykiz0HA0yFxRZQxt1wd2
-bLx2+4n5Rp082fvAQ9iZx9aYAZG6pOz8WoKu2jBpVDGhvsECfvWc2lD0aP/ct9vA
-6SiNLx7bScEDqmWUXorTZr53nmEUjIjEu9UfuV2aeQ5y1ZMv6A5quNFL1f0aDkJA
-efYG660ac7qGS7zaq6IXS51ZpgxNg7g0ZZ/34oLzxUSnyAi4X0l/FnqrlK117J7s
-o3qV7+rilgQWojyyjiqJAjMEEAEIAB0WIQR1O27PK0WP89GdVoweCiiDl65zngUC
-XHhLDwAKCRAeCiiDl65znm4+EACslfF5143QrxaQsS7UbdP/QHELXfaZubCTmLGK
-M3qHrh9+xmnRbFiMFtXNOCTuAwHZoKN2aFK7xmT/0ZbvRsXQrcOU/I8PbVmDHRot
-kn9Q3fTY/8hKcflsLgindzDqYu9etPvYaokWNPQHMH4HjsHV6VcOSRKojDTB++Di
-YJnbo+QZ6cUyk/oyYgo/vfO/HEUkVXsKtClpfAbzWd1I20tp6Z45cHruaUMNYk1+
-+W+NXVIvK8SJ0pG+/sNBjEQ4ueA7iDiIBhS/r2uvamunxcL0t9DAY9+dpRZOBzc+
-g8pPWfegZHjgAC4x2jjhFN5R0c1vd8ufGARP6R17j3tz1KXRrmqZ6AXolNkXsNku
-K+0Rfm0fDwszRy9HlRrmqPB8Jo3g+6EhaMeXi6HqngyeUVnydpE6T5Acsq71jyA5
-E2arQPf79/FFK559Wdc7YuHbEY+6kmuZ/rFmv7EHhdXqXfVMTOhRv5Lz6GgilLsB
-/ZnRre+E9VNoa8D/UrTDqcADYM/8ZVGsD2jF8SKxUe7RRDQmcf1pT3tXAUmUhIBM
-bo8nMGI4WDb+oCCQk6EfieSHADCzkZ3XWLmf1YStHfhWlKNpkB9yInFO+lPb6kVj
-Fiku+3DYnH+AgnxlheaX6tp0Mg/uxwZlCa4e4q7qDxqP09v0LBiMlF/3dV2Rh3D/
-uc5afIh1BBAWCAAdFiEE2tvMULwhl3fcVR+1LzrcjzWtjWcFAlx4TBsACgkQLzrc
-jzWtjWdHOAEAlhbnsoaIGAF7pscrNWruseZbir+Bwx06+AORLzCKsDMA/iVFOG6+
-Y/zvw+sxo1utHGcPYfbaeMmCJfz1TiE4gSkKiQJqBBMBCABUFiEEk4ai+y2p0NMf
-rwgYwMB2Ey/6dpUFAlx5XXs2Gmh0dHBzOi8vam9uYXRoYW5jcm9zcy5jb20vQzBD
-MDc2MTMyRkZBNzY5NS5wb2xpY3kudHh0AAoJEMDAdhMv+naVHPkP/Ai5VYNxbndq
-tJNy1RSmOEFyIm163VPHpvjBBL1fCZp/qV6ARNpDv4vlmptH4W13x6V63ON9FYtQ
-stbK9liHhD13hVwChbJ327iRsysgdNUrOqzzNc19jNtBvcvP4DcBNyWT2XdMS3EO
-KUnvltul3Awp9jEhNVpgBmEXyxoP4HIdQrcRyQjFejnTqJwaB8XC7K2tzSAC4l6L
-xNrnLCdGykpaO4st7c0LqH8TT9joa9YS2qoL1tuiDaH7iyMQnZ3j42VG58tyG5kP
-QSotsFaCymggMG3/y6QautlqnRrRkrnd66QT9eczIqR+OVr2BZF4ZY2y3KqTaCFI
-WzrUONJd1SSi1gm53eJks2iQZb0M36/y0wi7iSgHqlyCKJCywqavB5vx0oqVelg5
-KOlakNvh7Hs+kd2TtW4fTqFvEJmAjkJDClZOV0N3IdWC4xSp30uO9odLh+Uyr6nK
-xvWVPz9p+ed5soeP+SwOfZWQK3SeCt9QPIvWkxlqrGACvGtdNm/PbWSrAMD0+zYv
-IEP7p+KZqzUi69XFWzVX9lX60gWlAFxhrrN0cxxDOd+akEkR03Jd3fvGzRQshNTO
-Knu8e77zwP3rVOghBbG94qEhMToxPCvn+4AgbCLbI5toct+L7lUuH6YUbjZxSf5N
-cRQGt1+fLy9t+cTVN7JsFLbS6WDp65jliQIzBBABCAAdFiEE2Ujwij4RlwTNtdJJ
-bbTsFM+15E8FAlx4PhAACgkQbbTsFM+15E/Hbw/5ARkH3OF6kZjvzwt5+mxjx7lG
-MIbUBIYkOGR99SMxHQ1k0Q54DQ9tLYOpL/wBH+2XRUiwCfQMzsJZxnqc6sWycFTK
-JZ7NFE7KtOeIwtD8HlELbXTYcj5uPjMKySV88HFmShkMDaY11l19rHJva799rw/P
-gf0Xlf5WAufRMJb22X1iIoI83eddGUEQO6p1QYlpksRDrmtzTlGZHG/4/I8MwGg2
-b2WNpPNpIfHsX3NBs2l85/MBtHLz45lhj+xb1szgCXmaOGimVQBcYR6bhHFP/MSr
-CfloooQ/7lYC5chZ7sgfgWs2RQCYIAUP76u6fyyLbOQgTt20lIT4C5DqUI3hOU7/
-TEB/aevW9bmIfKiTL0DtU6NjDjrkp6fO1K3SJGPaaVt3blQ/BLVkuDabH8hXiNpt
-I7Z9R4JsmjlQgEM3niB5x0FkipcIEvw+fjdRQD2wtSCmVvYLnBK9eDeWfHDEjyll
-NksOuUzCGG1mrvS8eJVa9WxnzjdCSzGrhG3r7VDxfSCuoZ/iYvWpjVPh8W3oOEiE
-dTQ371aRN2MobHovxHhoY7KqIUB9BFfZvx9XkmsfVGaem4BvyFGbRJMcKhJhMb8o
-WauWGCOwlNO9l9WcfzxjfW5QNlN95jCcDo5FZXZIS3gMRC64QSY9nb5VxyiFkFFi
-q8YPWhltbKtne6nBGZmJATMEEwEIAB0WIQTpSKVTRkAB0mheRZfLcHlIHMYLOgUC
-XHu1WgAKCRDLcHlIHMYLOr17B/9vcjcd/PEuqN+5MylLTuox4giHCnFzhcmrTieS
-6o8Vmir4Lw21vZtCEni3Q6JSUESavtJEssme/HmDyWSjQ/cl4MqeHyELTm4QzP1h
-YintrxX5RB/ouTYnafzKD6ZBCUVZtiETm2xpdF8GkBaN7tlv7avPi3KwN2aeLpJf
-gCHkidxaTmGjHBXSKHw+mmrP1lF8AcHyU5Zjg3hz+oLqJsEDZllgCXkA3Q9kLbu7
-HlU8j8TXdpj8J1F6XEcYKGfbqxPXjmDJPd+rWloH3ZIQRp9qaS//gLCSqAbSPQRi
-XFzBBUOnHcTsVCZOZUdjYtFHfE9OaQdPqM3wTr9nblLu+do5iHUEExYIAB0WIQT5
-BYSbvixHTPrtaXHQetLC110c9wUCXHvb5wAKCRDQetLC110c97bBAQD3ZPG8F8Gc
-LZM9KoKcNAGRo9cfBZjiZujI2qokidYI6gEAkogjq8GpprUz4siBK9WLfYZ5+Xb0
-GfM4pmfUdMn+FQ6JAjMEEwEKAB0WIQRPn4n1UFrB0aJgYxzbEYe53V9pOwUCXHvb
-6wAKCRDbEYe53V9pO/r5EACxYcx0vsBEYW7Lc68ChtmWLLroGSR4b6JKlnFZcqO+
-cgrzRTKNv8DZ8bozSyUxDZzRcY6afU7g7xAx+iVdoi2iRx4VrD8wAmOTPNK6hcmh
-Jnne7KtIVgOermhSYvmbJp2KkLbTYZjv4SG2kEhbxrlg8SfplEn+mLq03SFGQlID
-otQcB0BTLJ7dJ31txK3aL9vlhQc356rIyblmptcgZsaCImW0+4eu6STQ6exZ4zBa
-b4yngx3YJuOvYbsMa7EUKu9Cx5MYr8Dhoyr9e1xBHa/QDyP7xm0HTOcbNHnNSNrv
-sN65jiM2+1b/2/l1lPEFZvOYLOheziLNAG0DHV8SzdUa9cr9sYR0bntt8horz3Po
-WS0AJCWVnDhVBSgKn/CiAA5Xyzyg+L2aOp302tPlRacElHtSK/vbL3tEmqqB1Q0r
-0cfUGx/kG3QNnPJKI4hmhIT+9lKK2kcpnZXy2m8olPSe4lP3Sq3AoIXfFCHyC64I
-AeXkBpznhSLyGPOrfzqUaF6+2irX2EF7hkT6h1aFsw+hwWHxvwjkEEVAavm+oncF
-ziKw7gNQMcZrtBPmwy33GBo56GKvy2De2IeJ+U3qdVc+a/1y5arimiYHKhkbpQna
-g/ZELK1N1gz2XwVKziLXKbKXT4tAib+BUIQcnpOl0/MqVus7Rz1FpYGo9VBmZnUp
-KrkCDQRWSImZARAAu7SbU8wL6kklMdeduxVd2sUxdtc81WoT3+//CVWuFS68pdWt
-BWQu92rdFzdjiIFVtgVMYfjZzRBai8Z4MsYiG28bC+vRUY2dL1ai6wdaf/2LIybK
-OGFMDA5i67nPsK63HZO0LPemmMsybkNYfegS0WciCGpi/ItJbqGIMI+BElcXutNd
-XVouNgAZJyg2QiNKNjcs5s1sWY6V4zFlEqxF/l/DxCtCz9TDnSJwaZVINtXelVUK
-8vjnyKWaEjYI3BDeTU6J/HANpUp0XBjsgIi7yzfQWGqK9UYo7HVDFzFFM54XHsCd
-LPiP3wF4igXuto/xPnhp3cFNMsrobjBjsCrxJUpBwfXle4dpnmNlyvhTsODOqyhD
-YaQYvOPe7/a7T2iCtD2NxHwuyBkppZTYU/LXGW9ot0aCUmW7d4WAigzyIpAZObiJ
-F/o+VnxEnh32UHjs10UtLY88D8wcTdNSixbP+I/aYlup14fbTDmwbGu2OQeopjdT
-G0piBsUCff7boT+7wHCYCsk5egW2LiJcdqPX9VDjNHROYliktSuWgjZaGHUi9Vqw
-HZEW+cn1IoCNsltdLM/FEf7IuaJtHDYtPM9M+CqcfhptnY8UW3kbL5AduWpSVGZ3
-78irLnRV50MIendq7Vtbr4XnV5OphXXHld6OdpPkUWi0bjgOEP18osTt0pkAEQEA
-AYkCHwQYAQgACQUCVkiJmQIbDAAKCRCbQysn0bog16r6EACR1BNADVJnpOhjanm/
-jIlr9R5h8SfRhwQQb+fC1iIi1NkNnkJoSPwCVFPLqKX502puvV1jHUZmE8FAsml8
-X1wDhn2KzW8U3r6Uqisr3SroPJzYF8KqhXk7TmAg1Xjx7b3CKTUWt6K0NkJqf8n+
-V3cpKTiqkk+cSaXra3sPnhXln4ZaNkZQcGIcbKs9dUraCpAskuSBFf3su38x9+RU
-tHXZ6EMFAydwbgC1y6DhcmPi0+y00jLn3+ovsZxqHc3vANVmL0Q9llejFzNdgQRq
-i6HX+VavpZvv267lHfsjWH7S3p13XzVMtMvki2AWigytir/5XqseSMtnuqGpWHNM
-MxsL7S/+xdD5prFcVFKKsjJbnxBDtXkvYc0Q4DaWRN4UNpb1qxTKtG/z0vSfy740
-j4mwkJzT7UJrer795c7w0Aek/w9aR1UFS9esQA1Hlx/teSzT2sREIxAiIEGGDK8R
-mlyKs30139EsYyRjj4WwwQmBB/RVxEYTJvoVgLo4K1mNyD0UrpfmTYoz9FwAkAHP
-uyCrbJpO4/cBcC2VkKQvFcpZqrIdPYfQuGjXOYVOsBIA0QzTSYajSea3Q0EHpQji
-k9IBoaMXGUDDv/lly+pCKlNXKyPo7jBoadSKAZdKnQRNGR+mVEHxsP3mzKswAR95
-d6Ux2SxuFVV2+9rKPE6VogAHAw==
-=9Lew
------END PGP PUBLIC KEY BLOCK-----
-
-pub   rsa4096 2018-06-28 [SC]
-      827320CB5E847C916CF49D06147473802F35F6CB
-uid           [ultimate] Jeff Genovy <jefgen@microsoft.com>
-sig 3        147473802F35F6CB 2019-12-04  Jeff Genovy <jefgen@microsoft.com>
-uid           [ultimate] Jeff Genovy <Jeff.Genovy@microsoft.com>
-sig 3        147473802F35F6CB 2019-12-04  Jeff Genovy <jefgen@microsoft.com>
-sub   rsa4096 2018-06-28 [E]
-sig          147473802F35F6CB 2018-06-28  Jeff Genovy <jefgen@microsoft.com>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQINBFs1QYIBEACyx/qJBfSY1EbCQ4aJTY4QE2R/u2cqCa/mpPMDje+GDZ6SyBwj
-PrXx7W7kQcwTTGrylJd1A6Wnx4PwAS3cCl7vSExYOsEbxKUcZiHVweMQBDNHFfL6
-bmdsXrHD0ypWqyCmbWdSGdwXfYhlX3UlntoETgHBrwZ+qf6Uu70cDm7cKPEoPlWz
-2eg6evT0vgtD1Y/47x5zAyCVqgC+rsEJKVdK3X3QSoUerFiYRUEZDXBc9KfHrn2I
-+St9fflVz6cNFExuc2x4H3b2vOwjZJqFO/cq47ppYXmOwbPQtH2r3hFpNxOIlQih
-h9MfWNiVKRICJZLZFicnFyANJVxK3ApK2Xhw4gJUjkhyXMZcpW891ETyOyuNjuDP
-cL0U6SqggfH0aubIfqBhgZqwTCp64Ckq28tjFqR3r7YqEFpiRu5mtO0gvk3sWl69
-bxXm0OxKLhhiVu0is3tokQU4M7zl7J8MHygBCkowoun/aw9Vis6K8ibItHb4CjTi
-SGGjNRbJxcPFq2YrU/c26T4IwtL+Mv59toXO0rJuwcaommkQ+G5zlBNViciyrOC4
-OzMiOkOrtYa6qaChAPfGrWL9ZETOBtVzZGpqLhYvTwP6Q7bDFoogtCq6iCU09FRU
-Q0fe3eJCeMFmVh84SWsdIe/GasXtWhvjlcmqnpz59Tzym8ZCDxhFaQt6CwARAQAB
-tCdKZWZmIEdlbm92eSA8SmVmZi5HZW5vdnlAbWljcm9zb2Z0LmNvbT6JAk4EEwEI
-ADgCGwMFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AWIQSCcyDLXoR8kWz0nQYUdHOA
-LzX2ywUCXegVDAAKCRAUdHOALzX2y8NKD/9Ac7MhdVXmaoIjTNBQA/ZbZTipzLkC
-RxCiUai2ThMHIZKSyWFszVySHSvw0Cj8ZFUuDW61HliT6uYS/t3M93Wh1fTC4+qd
-Y0u0RVffhWIYxoW5pzo5p00L6Lb3oMfY6QuvLgvQd6iP0gXMQyUr/hC1kVOFJ9TX
-16ylf4GwSpJhKPXF+Heu5jVgDw2Qx/22/xl6xSsPRqTU4rc5HOQmHr5EfU3LjmXX
-PtSBXcknV1TSA/0Nbdxm5vpYQ2tdDnq/c0RU0B7L/mxOy8gH6wWR8qM3x0vpCnV1
-hu9U7Rh6ldOpd5FEVBeDluNQZMIEQHBAZ174UCkvcS/x0RgBa3ZwjgBGYpanonJ1
-qPcEqN4qWt3uXWuVxtNyM0rFgtpJ6fhF6rGyHo7nasI68a9XEbUh5IsKcDttTZgz
-blLgHHs0IyXyHG2DYG8wr18viiLo8Vt5QpLPIQT9tAgnRoiD83kO1IUTktksz/jE
-sGzf2gbgGTYWomM11W8iMXL6flBdhY5oasiP6jwPTtpLjmqCLZkz9hEJ7NBgOi3+
-Aqeu0PMwets0WQW6S2OzlHDGqprWQvVqOSa0XRXSJT7ZSlGImMMZMiUMpVukJjCT
-N2z8lOxpw2s9AL9jfvCD7TodpfmGd1SHNTNRv/Ivt2eHrMhfJ29zabIfPb1nPChK
-b1EpNlfgeNl64bQiSmVmZiBHZW5vdnkgPGplZmZnZW5vdnlAZ21haWwuY29tPokC
-TgQTAQgAOAIbAwULCQgHAgYVCgkICwIEFgIDAQIeAQIXgBYhBIJzIMtehHyRbPSd
-BhR0c4AvNfbLBQJd6BUQAAoJEBR0c4AvNfbL1YYQAJ6MocZlLZ2107lYKOCPcCRy
-dnR4xi0t1jPOoSV6KNyC/frRppVzBodPGjAY6RfRM5jq6IVhqD6Nf9HEYydbn1oe
-FdD/mCXLiqtI2AOvVCzMJctoKo2NlxcnHQGJCvIEjMts6lWi4wDqAgTxOL6hov6Y
-HzsFjcDTZ3cVU51nFHM1tHLjYFYMALDy7CJ87k1GB3mTSAX0SIC7FVJmDWO3ooVP
-rqMofUvnlzBfHfJUfDsrOFeZZWHy4DXqHpMMebY9WLJv9W0LwgcLKugS+uxy98Zr
-QcQgHLh7Nl3yuYtftWL1bqAmBx4kTjQ3DAXDa3WYZQhV0DEZzRQP5pvlqkQoKp+x
-y//SzoFni55bJpH36F3Arp3flgFBpqy68ll3Y0/aOJ+jLD0O6/xQYPfG4Pu/Fly4
-28GQegfq6I/o+bc1k55ncT4+jpNb2a8n9TaFIs/uM2guowUisqt6WkB9jXrC7a0Z
-Cybt5iNBOuQVIZyz+BQvRMxkt7uESDPgo8cVAzr6DfX+GdBVAcZjm3piXWkaDxlE
-mOOW5HMW/3uOD2SAVH3+mPdvC37yFkPuTEoDbsgKRMIGolb3NVJVUu8+Hxyg0818
-h0G/zpE8XGNzAL52NrEsGyu8jN3L1BRFeClrP+xDas1I7lcohUXUHSnxJnA1GbKZ
-P2jq1DDDE3o06Ld3BFGntCJKZWZmIEdlbm92eSA8amVmZ2VuQG1pY3Jvc29mdC5j
-b20+iQJOBBMBCAA4AhsDBQsJCAcCBhUKCQgLAgQWAgMBAh4BAheAFiEEgnMgy16E
-fJFs9J0GFHRzgC819ssFAl3oFREACgkQFHRzgC819suQhQ//eepmASOFTA5CRegl
-Y9hzF0RP6+9kq+KTSbstbbQQuR4Vd2m0QeF4gT8vcIHEIfxVn6sfJFf2k4v/TKGE
-OFHXw8Wl4/XvquVpfp8x8pZ/XVd+/K9F1o6WZ/o2UUleEecIJENeSzJ69/WbGozE
-8HkSTaA4BR/G5bAzX79Rm0JfiS9l8ZyapkcPfSGO/Jcz1pkBfacAPyyDMxYFos6O
-y038D/2qSCLI3TxS/kS2FANpCpXwNUjvR04WfbMWnaVnOih/gqau7ZVQ20lpKZOY
-E4GcQtXVbaTR9vJ1tayA8FVpSO/4nqjD3CdI9JLkq7MGLnkHOdL/YtD0k+HtW8dr
-5p8m+tgjTmt7dAiCIXI+a8M4jjoIx/qAdHOOH/4T92s+zNYXd7CBkRp/6ipalfn+
-QnyFgL0r0z1P7hSwFckWKsbvT2CIFRgJOwoTAMJ26HsIqt9ZkMMwPwth+nYKRlw4
-WgmbZeoHZp0tpyqa7LTqNxVVxdx4rcgTL+jOOJMw+cQv4opv/pN7rWyFP6jCy5Wg
-DzmyrPZR/zEhH0h0cRLblq7Fuvl3t/pXxgWSAVYmAvTQyIwRWC0Nvec/AVRGE5uF
-koXM6nl27ZtGc7PwmqJZePi54RDIy7LCI09zdmu50JALfxkQ0Yg4EVFy+gf979jy
-ey7Fz42xM7+BTjQ6lXdmuSnIbFe5Ag0EWzVBggEQAL8RWXQwK1jupZkKCoGSSmuc
-FO9MdCS/744340u9TU0oFY8mmqOppoOiVi9EKIOWVHJ5a5DixsIdNkTAtmXM4a6u
-sr5wB555+KTkmofJ8nzHQM7gEaaHt9JErNo0f7Fzi9VEJ+G1XHFjW80HvQ9krpSj
-Lz3x0CbD4KjRRJWoUrKiBvNJjk2Xox28pA9F7lHWLgGTgZFrtCWMaViOcZqBZfAl
-pXYFR4hacddsDrRw4r4cl3NlfQ+SS4PL2kCcH1gx5AlhRkygtiJfYrHDvHpBk6rz
-r/IMojXOUAYV8TFQRyhsz/Cpx6rAun+aOXQlL4eDuNtWnxEeoHoNRAnAtUobP8iv
-cNqY1F3ZWaaY/MmNtw/FiDmYePzLz9p8FhBHKvE20Yt6UrUX+E9fPPSQDZxdtTzy
-LOWRUtOhMJIPigQRipFWng9d79Rf/goX5fcSPja/eqZaKkosYVlJW09+MxLkNO2W
-/UDmoVsvhnUK77I8BxqgrZUI8uj+d2cO+WQfL/RlGJ7a+icspycT8nTeEfCqjarQ
-wzNY5PLo6FN+N8dLVRyrQsPwwfWstA/6DJaRHgwhz6UdV7wYOBtI35xIZ8Cy71tT
-PycgOiUmatM8M5/INvqqPmQwl3VQdvrsD8MV3dP/U3edCSeVDKhqFXps47zdh/Ji
-gTssKOz6Lafg8Uo3314zABEBAAGJAjYEGAEIACAWIQSCcyDLXoR8kWz0nQYUdHOA
-LzX2ywUCWzVBggIbDAAKCRAUdHOALzX2yx0qD/9Wr1rnH2GZMwOCpwAplRegw/I5
-PNS36fOYs2YEv6/g3OdgSrGu2ZL+VqhCRTaqE7UrJDIsV9JORRptgg48nw5IGQlq
-NyyxMEJxu7KlpgRcKC6o1hZ+zqkLyQGdcd29i8AWBKU++MUunVMUplVuQa2mDRon
-KpN2lg4y2djfXvLOiKo7kLXnM8S+42gB34+X8HqFhfTXwz0Q/oroHayrU4ncTEob
-D81bOSQ2agn0Yi5GNCS6FR1Vmbto3j5HgvLbn9WkT8cM+nBr7F2zsZtG1Op+SKZK
-KJ9/eHkMJ/9XgZMcaEPJzD938+AscFUZeAL0QUS8MRlyYLFQKvQHiZDGgwfDcFGs
-7X9kZbMdx8nKRej8wqMOcro/TfogKSJj04L3qtsa5Cs71oVeUBalIlEcVkZIWUNS
-HzwDFcJtfh1lbjRm9ALLTEHwhoZ6/OxRRbixEUlGZGCn754Jt2JrcQGm+wqL/w2P
-ryG1zQFz5bcfxofFF/YxpHOsrMXb2GkAC1Wg9ypGfQ1RStGCe2q+6Qwo+csO7lyQ
-mGdooN2u+X2SXbBq+F2JxQe2X65SAWrFsmflN1vsY22IWExQE9kA63twjQu3iAF/
-mrLU2gvbaFZtV1HwYC3bCNqlx68rchjSQwOI5sdocwWsWbvJ28yi5I0PcBmyBa0Y
-TMuS5W4GIP4f7uR1uQ==
-=Veoq
------END PGP PUBLIC KEY BLOCK-----
-pub   rsa4096 2020-02-24 [SC] [caduca: 2021-02-23]
-      4569BBC09DA846FC91CBD21CE1BBA44593CF2AE0
-uid        [  absoluta ] Steven R. Loomis (codesign-qormi) <srloomis@us.ibm.com>
-sig 3        E1BBA44593CF2AE0 2020-02-24  Steven R. Loomis (codesign-qormi) <srloomis@us.ibm.com>
-sig          44CE7BF2825EA2CD 2020-02-28  ICU Project @IBM (International Components for Unicode Development at IBM) <icuintl@us.ibm.com>
-uid        [  absoluta ] Steven R. Loomis (qormi signing key) <srl295@gmail.com>
-sig 3        E1BBA44593CF2AE0 2020-02-24  Steven R. Loomis (codesign-qormi) <srloomis@us.ibm.com>
-sig          44CE7BF2825EA2CD 2020-02-28  ICU Project @IBM (International Components for Unicode Development at IBM) <icuintl@us.ibm.com>
-sub   rsa2048 2020-02-24 [E] [caduca: 2021-02-23]
-sig          E1BBA44593CF2AE0 2020-02-24  Steven R. Loomis (codesign-qormi) <srloomis@us.ibm.com>
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQINBF5UP8oBEADBaR5vyDozi5LbtcKnemb8Yy4p7g8Ki5gO3K+K2MxeB9wf6xhU
-Qp/4bOYgpdCmBDT8bhEENTzNFxIV3g49WxC1LuSVeklcK7j+VJKAwtysm4N/648U
-9NUr8N3sKaKbj4qEBWPc7VZigbthpFEHT4TCZ2DO8XFzZfnGbDn60SvVWsh64k5L
-hzLKt6k5PRIiQufkJBtRqF5IHGKjSH/W941HrNfLGUodcZzsDqqtql9279BRp05h
-bsc4cNZzdNrrWNSOAmY2F7ar+JjFDPPU3utyU4rLfIXJ9EbTTCLA6OKygTKPhtX2
-FnS+HBtRYOkzH3KZGpJ79agGgSAxKThsL7WmRMjssUfaaL1Ay4j4HCUHqvXoQe6h
-SzNgYhQkN7OjTLYgXXBdjBwAOJHH4tNeHkbyMF24d85MzEraauYyAFuXVldHBVBO
-q9oNJktHbVwNR7nbSz63QA/gLzqX01HFu9NQHSF7yRMDOog7gLPEtXp2ZtxzR+FI
-sReNU0UwzUrvM8zmlnbwXQMPDBCj4uhLp8zdcTmNgmkVUm9tPumGNruaV4W2vjD2
-vowin+GGvXjKYkflGO+GZoC5SzG9k3ef1J5csRD9oDswNomq6Pa22vujnzacGc8n
-r0F9TnRcH+ozbRYMz1qoklSH//XJFH3utwEyk8VRuPcmqKdAW/lz2pQIbwARAQAB
-tDdTdGV2ZW4gUi4gTG9vbWlzIChjb2Rlc2lnbi1xb3JtaSkgPHNybG9vbWlzQHVz
-LmlibS5jb20+iQJUBBMBCAA+FiEERWm7wJ2oRvyRy9Ic4bukRZPPKuAFAl5UQUMC
-GwMFCQHhM4AFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQ4bukRZPPKuAnaBAA
-p6J21ohqnYERDp6H7mtwuSVVp2aAio8EmtPt5Q/1cwHg653T5DMZda0lBPOpHzhM
-3p0W0WCPpxu5GuQ0vd7MMqollyGHmL8fOGAsA/REEzyw3SAyjzLHbb3quGn9U9qE
-7/irizA2SQGAXKbyK8JNorloKAg9Fv4S6DsfUxqDBb6bMyvWqHNsNNIHbhVvLblK
-yJuk4acWowphkLRC9YuM7rAaalrOO3C9PozbHtaukJZ0sBifJRG7f6JICoDpwujW
-+zXkb5yuLQE5NcuZhd/YFo9HSKXsp3D75hz4/bKxe8KCV3POt2RJqONhJGGVU6BE
-EXr3J4lZWUic+8gGefAMxAL9WbzrOEmfesTrSkzkX60+3qQ1lzqv+f91fWpBz2/k
-RNN6UDM/jbDfUHhcEQ82DHCATY+/LTcjN54gBnhWW8jszxY9/s670nLCaGoGC5IJ
-haVaUOA4zKkgfv0Uewc2cxunYKkoK7KIu0THNHSDToivF93tN9LOmWpSah4Bx+of
-ik1+IPE9xXqcld821r3XXQPYKrdv6bXmTJLVbkKv1VQsP90ytuZ3eQJ+FZvs4Yk0
-UHqW4QDV2A5ddEfUdbtXDREUDRQtY322s9jtafGfWanZ5/BpUKnb/IjYeLYcLEwy
-uqbC8b9ljDFmHchsyKYPQQzdu3AQhkwgDWAbfaF/YoOJATMEEAEIAB0WIQTLMZDK
-eEJDnlfzcS5Eznvygl6izQUCXll7XgAKCRBEznvygl6izdOnB/9HyeOBHpQzD91t
-f84/oGFk+uSNJGG2XdUyHw1O8zEvCCeCrE/7nqstp7ZAJ5Q34zgc8Y71L+3EFN99
-dTB4WDsXpHA9cxc6ij4pA/IhMr5phorcUdl6gX1EbGSpM6yaS8FKJyD53rhklSyM
-qZSg0H94Wg4rP8i5+N/DT5A8C5l72GipkXRz6t2s/VJWfFIeXnxGFh+HzJ1PMKoi
-HXEbnSQRxd08hm+G5A85K8ptPVuDVojRLLu5oWVrMvSc/xXbQMIeoT9z+z/2DFWi
-KWfXeujwoLdibJriVyajqrYchZcCZ0gVke7CEgIPupO8ngzF2TwnCLPIFWjNvcPm
-5pZh+cs3tDdTdGV2ZW4gUi4gTG9vbWlzIChxb3JtaSBzaWduaW5nIGtleSkgPHNy
-bDI5NUBnbWFpbC5jb20+iQJUBBMBCAA+FiEERWm7wJ2oRvyRy9Ic4bukRZPPKuAF
-Al5UP8oCGwMFCQHhM4AFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQ4bukRZPP
-KuAaHw/+OtiQoOP0CHwjYiOsZZdr725HDCGZ4jjWTP/oVda8Oh9yJfzG6NakMzbt
-Ek0TuqIkNiGwoxWiwiCaeNXK4QPdMIOLyyE6fIvVWCqbdNnOIGdsl6uePKq+MwIy
-YI0T/HbFqstJhLc6bmJZOtmEa2kzHcrmNAaAzbNtY6dgr+e/2ChUCUKbQesoKvBK
-R2I1VJHPfJCjaj6Jz1aHH3k56mUaI5ZLrQ5ZkEbIXnr8mRoJ1gvvn+0ktU/gWKUz
-YSEH7grkaI+gSpQgZ45otYolZ2z0mDzVj30Q0MYmDb1TBo2VonoZXBi2a+w/OSU8
-1L2a+gncbHjEqtOWHuzljM6yRDAQns+TRZ+vfqb1pI+d2NNl0EjqQowfKvfbdWt7
-HsiUZE5p7v1b7+BvxXOP4aieezbyn/eye4gJFxJVKHHd4f81rtVRO//eW6bOmpv+
-FQltv9GNJ65RsifKbaU8DCjdEWarechw5Yi6nj2X1NeHYrYgkbcntAD6bWvG6Eqt
-dgXInb5DceP0jJMqXAI453aUle283gPydOtIimJCzxiCwub1A7ozy/X8Li4OsT5C
-vqMoYIAF2jiyaAlqADcv3wT2qrKFpxGV3n1ZFPgRVOp/N23OF1Zq6JnOf5F19vJ3
-TgN16KI9oi+nq7Sr9140JqZr1KWovUHX0j33DvUgskR8YfypY+CJATMEEAEIAB0W
-IQTLMZDKeEJDnlfzcS5Eznvygl6izQUCXll7mgAKCRBEznvygl6izRefB/9jXPRk
-73N1ywqoGejXxB1dIiFlm/8r7F5IU624fc/gt8mcdqJ54rBiC3ESnIwT4wGG+8GZ
-Cb74PNmBoTlWe6D4QT4XzJlYQOYeQjtOEGm9KKCT5bIXCbu/z7Dd8eDrZTsuxuxw
-7o4pDfEIlQUeSx9QOkjLK46vSVVOiuLOjiq0XIYqbmG9MHjZ2EktwVLQOzf9biwz
-IOItOd0XzrxDGI4iC0A+0UCvL4ZHAL8yuuwuL+jGaM9RipEB+9kB/qNlEaRYZost
-XNTwPeLOln2yqFU5V0OjbWZC0GTN8PP1IsY34jXYSTqIkDyeOoUdyG8YXWhe/eKY
-hj4iq8Y+Iy3oDU1WuQENBF5UP8oBCAClbz91MxtQgqgn1jOBPh79C3tSyscIQwfX
-OxrIJxg8gOr+BAr6vDBIc+iYFO380zqGloCfWUqsVdBthzwErzjyEByvKWLfbnP7
-WHpyEWpaUTS57PYX65bLiLnNZMCmgmRbv/xdOL9rEhwLHBtjo0Q45vNXFVJlUvcu
-H4OiL7pnfP2VPRiVzlYgLRi7XpvRMyh+PWhkXE+o0P5blQ+GIuhTJ5U23PRCrFA1
-ElX06Jy2c2GEJeox692wxUY1QHUvyLujPhPWWPKN4QJbPoSi+FOCOxKakBNs2MeY
-4zBYHzjZJ2to8kBmdkTEIKy2aC0OO3QTlfmsJcKjPUeaAycaN479ABEBAAGJAjwE
-GAEIACYWIQRFabvAnahG/JHL0hzhu6RFk88q4AUCXlQ/ygIbDAUJAeEzgAAKCRDh
-u6RFk88q4GRRD/91E0HRVUu0mhJ9IL/0UQV4wRTrrS7oLNoOFFQHHdBOXBbNvH51
-UxE3dmHYMVz4Mx3QuwmiqCTHeE1oHSjuOoSfRu9V3gG1HpIBnUdGtcYm95setIeG
-yZ1Yza9P3lweWGp9ublhXqzAJ/SqYTFpFTQA2cBAEblU9hNlmBi4DJTqz7XzNXUw
-RzU9U7hRMzhi9JJybsLfDJZap+yK1YIlMqAAMtveqKqWkEyFwJRwZIy8BYAfQvoh
-N7XHvvvq53pyzOX9H/2YNFyBUiG56C1irxtJ/hlprFryYEjiilWX8UFVO/rBSc4o
-n5hSP2VE0yibJQK8uLiA+cJvOKoYMe9u0mPeUa7lf4aQSBBctwRGYZMZMjri8lI7
-+IQEXgb+Se+5DoM2nfycZF9svgwam+y92d31yklJsdUAnMUIn6XCLJmrO5I7qQQ4
-bYUInhI2r3roRIskLASta3JH++SRVeRECw0k6NdkPhXFbjkSv5TZYk8mX4bfYf2B
-+pbB6xMqy/qygfOgsnTZnA2b9i/agudx1Qg7YRZT0M2YsiWLGBcJVGLnBbirDORB
-eCVlKx7ws8kC/oPcNgwoqs8XdHPNHUr+MGWcoib4Fo+KhijYGIM0XNfc1fWRPDCm
-DH2mVgW7fxtB1JdUbJPU1i9vzAONu6ZKyrK4d6/MTGduyO+zZISqVKS7eg==
-=DEHc
------END PGP PUBLIC KEY BLOCK-----
-
-pub   rsa3072 2020-10-14 [SC]
-      0E51E7F06EF719FBD072782A5F56E5AFA63CCD33
-uid           [ultimate] Craig Cornelius (For use with ICU releases) <ccornelius@google.com>
-sig 3        5F56E5AFA63CCD33 2020-10-14  Craig Cornelius (For use with ICU releases) <ccornelius@google.com>
-sig 3        9B432B27D1BA20D7 2020-10-14  Fredrik Roubert <fredrik@roubert.name>
-sub   rsa3072 2020-10-14 [E]
-sig          5F56E5AFA63CCD33 2020-10-14  Craig Cornelius (For use with ICU releases) <ccornelius@google.com>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQGNBF+HGz4BDAC00mXlPPgW5JI9ECxI9juSX/xpzAhM/2NvXzc4Smq7efJMzwvp
-PkfBwdfLavred6rxqjVfXJZuPL4rAY2fKBiMq2ANBRsx6t0Bl1jbZbI/MGb18Qcl
-5EQ/n1I0WslugsZpSX7crj2A+bO42BRWOgroQdpLTrdTjdeS774MaOvt7i3S2Cy/
-YT+U6ysrz0F7wWdiOMUftHEBOrXPJ0s7P3bJGDYsca0sV1o5EmefQWh7cymhroHQ
-UzUnsthmU0Qv8BQ7xc7zsCBrJR0V0ryMww8u/mLexz7i+W522olx78Vj887yC//l
-lAZYI+ORG9hTr3QvcVaF/FaZsbwrA3FPKB+LFWQr/CFa0eSuXWoY5u2g3aL5KoCy
-G/l7x7YlMx7Rw+65YIssZYv39AiZEU3nei+qsaneNc8v6HTX39mMXVdMc5/iWV6A
-pBo+1+wTgaOWMFf5zOg38DyzdQaDz6TNoAgwHbQhz7MDHqLZssy2U/ATnqAPi/Xf
-8oGmQtTh/8zupCsAEQEAAbRDQ3JhaWcgQ29ybmVsaXVzIChGb3IgdXNlIHdpdGgg
-SUNVIHJlbGVhc2VzKSA8Y2Nvcm5lbGl1c0Bnb29nbGUuY29tPokBzgQTAQoAOBYh
-BA5R5/Bu9xn70HJ4Kl9W5a+mPM0zBQJfhxs+AhsDBQsJCAcCBhUKCQgLAgQWAgMB
-Ah4BAheAAAoJEF9W5a+mPM0zJ5IL/2XJ6VHdSdMstzjpnCDjQTYuhFkwEfGSlMrh
-NTZftoQ1qYXuU8DKISgEo1OlguuK61xePn5wl8BfT6XfF2XCZpVUah2fom1m9ZAI
-2gkt/Bwn1ulBKQd3NQpAQKPSKzOVJ+0BBOoBCwoCy+hHr8oeRJGgxz+j8aux10IV
-OHviRkBizx0vnVcy3dN41QLNvtq2cZcKig68sAJPM3t4//co89pH3kjKzBFVCqxF
-+INFyaGMyhT1zEhEYB+o6fvYGS4zX9qGRpLzx64beA84DZRmv6aLvjwzhSfA74Ey
-f+FLiAMYae1opWLS+to54WEB5hK2Ak251PqOfWCuR1KVGmQaa4sabT3uQQ4bRh6Y
-yGSXD9qSRJC/W2vNRwkEnKrjwFgH6w2XgHpVLlJiC6Ir7U57AkCfUDVdRCcWQoGN
-ZTuPIHfoj7IehJgR8NZvbHAWViC3FP9DZEm+1druAbc62PngbTUlF6zJrZ/zl90s
-kDg8cNNhQtCdgDKdL/cZwBm/G8RzUIkCMwQTAQgAHRYhBP+pEpoYDXZbelvqHJtD
-KyfRuiDXBQJfhzz/AAoJEJtDKyfRuiDXegYQALsvJzFyxsYUsgr7VYTlyEmjLx2k
-S87toZTgD4zDHprjGT/mRQvzKaMqgp6cwGtnGYLbJi3W17E6ykMk523CWfrqAbqV
-RpMH6nQvxNEbjHCAOAlXZc2zk78lqHkd+UePpDW0Lzwllyf5AK/hf9AvHGZlRSij
-OZEpvRIinwczPcKCyy1RdwO2aeKyjrk98e8SKjpjdl/OXFiuWpLWUXJE/QOkIwbk
-lwUUgHQuauuKZFDDu1T4AGBEAv5yUWU7m6PMa0riGQ7m1pSvTVX9wmV0KgkQ3/sW
-VDqYf3X1VL/NVt4GUBAFTCw04Xzqdb94ERlYde5yFn/wJm7Pnv5pDIlxvGvL+uA6
-e9oGc7msHA2PQipDaAPcvmDT6IQLLuhmO9PA85vZ8egGjKKBey5FE+zRrowWfsLm
-WRXeBPohHjHY2KRto3onFJM4JEydKLtpBAGQ04NAGAhth3opd3jpaPSu6u4rhx4i
-HLiBoJzsWtRTE0lTAeA6Wm0MoH9JQQEt4rgi5kd9BcYMsDfZiR9Fsq2FwEkPwFbi
-1sSNaxW5BWYFKSBhYHOkAFzsxRrD1OAj/anPO/QYfRUu08hUyYi1StTa/0zZ+Cf3
-NY/Q3YJLe/6PJrzxv4wnE7QZs5OSUDaTqL9kOB1mDz6Z3iLnPeOnkBuL6JFF5IUv
-lc9Y1w/UOFefZgGguQGNBF+HGz4BDADO2pKzANvXFSpT5IeMCcAvOIAf8vgJpO84
-BnjQ9/PgiOZMmQW03TIdrY+9wKATOxvUTpC7G+070M438UwlGJX86zjY7CxX+xdi
-yqL9ArK5QJlvaXSvhx1qcArgLIiW+X3qs/U/Y/qXOINS2gZUSYaMBnWHuXutIXSQ
-Liqi3l662nSPvKi7WjpHgi87TV2zG+B9xF23AxMZWth/0vtIevIheoB8vk0mJoos
-8Hmx1qknknDqICT/Flnxm9f1UyDOY+IwWMRyvZZUr+r08vg7qg0TIHkYeMwRMovn
-TZcAPoNvFT1yHsxTdf7mA1yTHCymBvP5aUuXh+FJ+tfPmKGvz65bs0VmlcU10Kl+
-EntF7TniVhCp/QB5M8MA6HKM0zxORFMGZ7xnV9gu6nRPcR+8PvEFxY2wgEcf7pfj
-6wGdS+Pri/dK3+53abE8RLi/aJW+wi2XNd/aRWjpSC2KBmHUk17Zgp3Jb6Iijb+e
-7bcTf+REXgi0+PskNaeCyU4LzStZCwUAEQEAAYkBtgQYAQoAIBYhBA5R5/Bu9xn7
-0HJ4Kl9W5a+mPM0zBQJfhxs+AhsMAAoJEF9W5a+mPM0zULYL/27+ED5a8s43q24Z
-COQQPZflXy+0MnzW4Fwj9f7WsxD/fkj651p/MoRVmugytRti3T8+A4ULUuKxatCZ
-+Q+SIobXbWHD0H+hVosBohU6HmWX5Nbyi18wk5YDNWzN4n0duhv6lb4tn3M9utX9
-WdQWnudXNjKzddNiixalc5eU9vMEpEnwKLcmZ/efSimqfGXldMjTw+FIh6wHhBcn
-uVXQqj/+Ie20l4RXt6VxUltZwaQJnH6nf/4T1B4sllNo6Sz8RHjnrc+SjuIREaga
-nRNZ0BF6aAj6qk9RTlkmBGiAdmvtCuGRqqsG2i8OrTv0xArCGEs13Vv5BE6JfZI/
-YdzPuq2u0JM9dsEM6v3LLxhlfxxOmM9OZM4+GBP+JMB3TydK8t7vfLW0kDRvymVM
-uVrQ13BH7D1TWlzM7jN1dToIySXIjGRcMpqT/2LqYDwHfrJCdQZGKDBl5vNWXwNs
-OC7PFtJwtXNl8p7Jvk3lplU5rNSSkTF8hSFgtxu4cpJ5VAY8nQ==
-=FdGa
------END PGP PUBLIC KEY BLOCK-----
-
-pub   ed25519 2022-10-19 [SC] [expires: 2024-10-19]
-      58CE54B8055CE53E88FF370E11D97A7E0C3C56AF
-uid           [ultimate] Rahul Pandey <pandeyrah@microsoft.com>
-sub   cv25519 2022-10-19 [E] [expires: 2024-10-19]
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mDMEY09jZhYJKwYBBAHaRw8BAQdAGH5S14WaonM1ineKLtO+Ck7mDENF2jmZKvVO
-cJvbpHm0JlJhaHVsIFBhbmRleSA8cGFuZGV5cmFoQG1pY3Jvc29mdC5jb20+iJkE
-ExYKAEEWIQRYzlS4BVzlPoj/Nw4R2Xp+DDxWrwUCY09jZgIbAwUJA8PuggULCQgH
-AgIiAgYVCgkICwIEFgIDAQIeBwIXgAAKCRAR2Xp+DDxWr66ZAQCgqh82arS3QGQk
-VuhaNy5b5CYaQQlxH23Rb7WRrrmHHwD7BlHDmWrFMUSemdzjNzdDfWImP+ZbrrjZ
-2mXD2DwfqgqJAjMEEwEIAB0WIQT/qRKaGA12W3pb6hybQysn0bog1wUCY1GUKAAK
-CRCbQysn0bog19CpD/93KI9fakIOFzhLqtnvArKVt86Lrlvp2GeKTPzO2QtnSXfR
-yHEx4bjbRZ/qAmcnIX164D0PlkH5zPpf3yGZxlOVSrggVAWIZZ8rHWqyq7xGbnK8
-3vaTO5rxqdEpDyPpBUEGQpvaW29VDzgUuMGjX518P/O/NIlW/S7nKdRJotkLWPe/
-SBXyJorMgSk4sLDTRsajJpYwGGF8OCFrvLMpNUfmoZ2pqi+7Hefu/Oij51fbRx09
-rc0nTjUmnthgW528pTBAwVgQtZorVAZeRdXsF25N2hCJbnUH5WkjMp46fu2mGuE5
-XiKyS8PatDG5XDgtRaQpd5D2czYZT7Pwmrsx/8SE5YHbmbziy05go/QOc7twzmaJ
-sLEmZoTh4XXdNDiC1RDlUHSXjafkipoUaSk7/5g2jdAHe+X1EfOiACSg89ZID8fc
-f6pW1cSXFPCGo5jZrHKsLDqMD7dqoFnov2dO/cfHRVTxg/EcojqRa0jPjSksGPFy
-9WSy2g2xIXmw2nHIa0OiJnnvXBLwloxGhAkpmgb999LivzVNZX0hRguC678Gqk1v
-qzDopc0hCFskDlCFzFifQaaPb7rOcqK3WyebGdPkDeDL4DP4eY5pw8Pj+lI0LkGt
-SwHH03X2gna0f5rwpykc4f5kD86mv6CE4XALvjoBc4EQRx4/0+9gwbdURmlwe7g4
-BGNPY2YSCisGAQQBl1UBBQEBB0Cu7qMlH4bkpMRTp+U1QsjKgMLhaL/dqU+Yc1QM
-ds7sHwMBCAeIfgQYFgoAJhYhBFjOVLgFXOU+iP83DhHZen4MPFavBQJjT2NmAhsM
-BQkDw+6CAAoJEBHZen4MPFavJewA/ifwCCEfa2n+qITLkMasdx3CkrgUWV7vEtmU
-+GgJdffFAQDd6J+JTS8dKp3/b0fBnxt8OsqcnoADsXFhU2PHQcS5Bg==
-=adGB
------END PGP PUBLIC KEY BLOCK-----
-
-pub   rsa3072 2022-09-23 [SC] [expires: 2024-09-22]
-      3DA35301A7C330257B8755754058F67406EAA6AB
-uid           [ultimate] Craig Cornelius <ccornelius@google.com>
-sig 3        4058F67406EAA6AB 2022-09-23  Craig Cornelius <ccornelius@google.com>
-sub   rsa3072 2022-09-23 [E] [expires: 2024-09-22]
-sig          4058F67406EAA6AB 2022-09-23  Craig Cornelius <ccornelius@google.com>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQGNBGMuLsgBDADlGlqWlz+oye63LFKIosGcDpRrnHSUBr9MN4Chp1hBf7Bw30Lm
-Y5EjfTIV1D4YLj3mQbGuZ21/+IJRSLssvsv9r663Hou2EYPMP3ipLppH4m0+yaHJ
-nIz9ju1NoFiErzfsBPrHnW9Kv145vXtvD7xXT4YMmrLWpvUhFSRF5AcCSQEWLXqS
-rnaMV6MOMCDiFkdK1aBt+rgK8SrRSKJqR4h64g2m/TTYUuEjB+ZjYcOB78929OMa
-JsXwmZz2QNKeOR4lGQesb8hVcZz05R97oCCAtfJ0nHfHOLCW8jWErTOe5YKpSCnQ
-rqzwkYos6V+CCBI+lDGm3DeW/FHx0GAfuOgUQOrFk2EGNND+nuGxaFFwKMA0gVWZ
-y2K3u77DL51lEeKfQTO/06g1riOeYQ7K+Ri0nhh/yBjYqCTL909VtD3EfrUijddg
-tmD5UIzdKGbXjtvB1TB2qiO2W83dIJ8Sv7ysR8pVtELKB1z+yPnmgXZq86086cx3
-7yC/fltlKX0nJcUAEQEAAbQnQ3JhaWcgQ29ybmVsaXVzIDxjY29ybmVsaXVzQGdv
-b2dsZS5jb20+iQHUBBMBCgA+FiEEPaNTAafDMCV7h1V1QFj2dAbqpqsFAmMuLsgC
-GwMFCQPCZwAFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQQFj2dAbqpqvzowwA
-juaPwz+zHVAVmkSS/eC4E8lpkEUZvr1Ydt0twpm6jCmA2YbNtobhelRyB7W2OKzO
-y7AUyVMQXrtoyDF5Y1RcwcVWt8IxiA9VF2egSyNdZoDQgkXElnLnXJqWaQTjA6WN
-0mQugbIl4gs37UK/BeEt3eaeHuiCewWl4lyN9IaSHsGknHMfgTJLvPPLaWtzPzHu
-T4ORuVgE6N7IeZEMbMDyZfdssfsA0GL2rxJO6QDybQ5e5sOV2WiqYpzwoQkUJiUp
-HmM+IS+6EOndTxDdXJ0vH5I9z3RxAQttfYY5rxgLVbDHd7oxfCFw3p4eKpuvFPAN
-7fiEUvVSQWsuzH4ttNx1KAfUxfjbafM7cp577ZRLGdZyIPngAi5iUOE6RQsm+LvW
-TDMZ3EN5+Gz5C0UlCslX7203NKXJ4z7f5OreAb/WMEe9N1urdNb1jp6oB0DAP/hb
-BKX7kVNjVFLwTLZnRD1Af32Qgg1zZaUgZmn68LTTne9jTzhmtGhBQzu1Q6kNIoq2
-iQIzBBMBCAAdFiEE/6kSmhgNdlt6W+ocm0MrJ9G6INcFAmNRqPYACgkQm0MrJ9G6
-INcS2A//QVJjJ1Dvqne1XrgMjXr1XaamMKq6EWYXeKHZHfvhU1h8fG9BiVUcIkIV
-59yStG/IiRQ258E4l+5yLaZEtSG2ogF1cLJZ+eV0TldwNJfhFCCvKEF6UUPizhj6
-vpsWw47mriuzA2YNKndUdUDeru5eDSqzvnkAH0I4Mgbrg7MPfd2QcrNFj5Tv3EhJ
-3pyQbTGMlstisXGgCtjN0tKQBrrX5zZZboaDJzUJEP5lBq1C9to+SMUccM9sabVN
-fXWsAWxeAsRSgy+pXJctzMJMxIHaHzGZjsAzZlobZA6HfFjRXEpHfBFotPZg9Uwd
-eGXFp7jqLD42mVwSBGKezc0huC/OGXkObQi5qKJU6RxMnMOVt2f6EWmzk+D0Eqw5
-kiqgdsPXL7parF2tFukXVF7R+JJWPc5TIn27FfeK5QDKgL1sIOR8GbF2Lib6ynlu
-NMd5Cxx33wWCTDFyyi/3t1Y20B4vNOWDXK9PP0c++jQng1DCpxQfJ5KYbqBfzCZu
-luqJngwV/TNwwxQO9RgZ1n+ZufFFOVjcFiqhiGcPSDGR7bg3QqzqdzVmgocqz0wZ
-2KtWfCo7i5AMNNZCFhepni6/455NxQhOmksHDAhQrOTH336FJYOVd8eHHazhRblL
-W5iSGu0aLmLuo4TbImgNQf+DkBP1/KVQ94kMkb/0yo+ZxQstNgK5AY0EYy4uyAEM
-ALB5zm1DaCdkKpD+M3jsFlNT/leamYoyRPqlKkfRRDAnc1ORvbf6WxDFO8YMlE0O
-r9CVt9l+RJljXBFidIwlo1qNVP7+sQg3tvkz+/j3sf0cGyUhC2Vrca9HxwHRkbv1
-BE/89OV4p5ZT+7DXjJKhgIkXXSKeuM3SV6EmfUGDxkm+K5+2EOzBrNlJf+eIDvs9
-bb3Q3EBfTsWyb5D9KTt5qefGQH50NGsAwUfRV6cH67boLQqq1v7w3tJZdy2RA77I
-rVbJpp+9Vt+SQIVi+xhNULwbO0wEsQ/GgY1F2RN2FMy8ghhH+hseHvPntpXHmhTV
-pEjHSHvRhgDpMsaSyEWBY4VXjC/cGgye4QfcemC+ZR0YG+ZjpO5rwW+CdqltkGRi
-XsoAy98f99QwUKsuIPFdsqBGAj4ZZEiAKeqVrOpdOSlnUcw5DMOYnsdQKNcApTdX
-8rEnujghkCKEeJdmZE7CRaOoCWqmWhuQD6+31/JtIBNIR0ghuHHfGtJTukoxTC2k
-VwARAQABiQG8BBgBCgAmFiEEPaNTAafDMCV7h1V1QFj2dAbqpqsFAmMuLsgCGwwF
-CQPCZwAACgkQQFj2dAbqpqtAzwv9EdTANY0sj5NpNE9/NTpl0v1ntiQP0ARp2d/b
-lkuqBDNbim051pfSYkL+0pLO71GQHEDoQTK4t/aId4btA/UxwaKHX2wJmKDbm9EB
-nxh10cUQrc9p1xl/2DNp8q//1p+g+WaNRAz4fyeu3a8KczMTJrfnu71FG6MPMC7d
-0tQxvcyrvajK5VqNpXmRKY5l5Rmbxlk8fhkH/8tGxferl/MkszF337qJ779Truc/
-WhTtd8cPagAMnf1Oz+HGwpfetxSJ8kXHXkCRv9PyoeFNx2wukV727bV1OqavQHKd
-RBC7wEauOGfDyFR7Y8xyd8fPSVJuBa9abKUiaAO9vQ9ZumDsM6yTPt8oykslK0UQ
-UXauuSn6mbPSiadvehEhRq8AWXTsvZ4jZJywJ36p7NR/+mr7wsOhL9H0CP15vjEx
-7EfyYWBMNER7MsLKZyP/60ctmCMD6PAElOZuQGlCWEW1ezeHSFwijayczDQFdeUl
-wkUpoDU7dMYRZrtm3BIMdgntWYSs
-=52fO
------END PGP PUBLIC KEY BLOCK-----
-
-pub   rsa3072 2023-03-24 [SC]
-      A388409F41D489C776CE75DF554C6CE339D10995
-uid           [ultimate] Rahul Pandey <pandeyrah@microsoft.com>
-sig 3        554C6CE339D10995 2023-03-24  Rahul Pandey <pandeyrah@microsoft.com>
-sig 3        9B432B27D1BA20D7 2023-04-12  Fredrik Roubert <fredrik@roubert.name>
-sub   rsa3072 2023-03-24 [E]
-sig          554C6CE339D10995 2023-03-24  Rahul Pandey <pandeyrah@microsoft.com>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mQGNBGQd6fQBDADIyEodNBMm+/J3G/awrbXK+0S0tQwiP4pny7mBxv5V4sPrqIvC
-XDGu5gHWU+rRm0gx3aTWelEIqBnupgR9WZN89QCxkLwzlEVLcLXxgvUk6VU+J3h6
-PuEYyZkfj4Jw7UJknV67GiIETSuT2GGtoOMJvwMRJnVMg4KeEs5+EpKUC31SScyP
-2ocPkrOnpQMCTOaeLKozyPqArEG816L72EAdQw7hI+asRY7DJcdVyqeZ8J+/uxIV
-O/zX+u5Cgr9UVTU+OXyODALdZ2O8KRPDHTBnCqxZFDmPBnFjnYYiKvzIYhTUMV8w
-LCRqQWA2r7lnxmqn5FNW8j5Ze7pnEPMOHcQt4ZR1loeLLEfQHP8m/sa3tTBYgoC9
-vd3KaIhOV/Ez1z+Kg4y+odHDmTMUCpGU9uBZSYbIEqwvGh2O8cuYf/VdgFt9pEgj
-PgdIQCWhiGh3f7Kpf7/1ksWteComgB4I62d51aCUnSBNU0G1HrxKYPFoWOwzNZiR
-T4IsPpGl+DhPmUsAEQEAAbQmUmFodWwgUGFuZGV5IDxwYW5kZXlyYWhAbWljcm9z
-b2Z0LmNvbT6JAdEEEwEIADsWIQSjiECfQdSJx3bOdd9VTGzjOdEJlQUCZB3p9AIb
-AwULCQgHAgIiAgYVCgkICwIEFgIDAQIeBwIXgAAKCRBVTGzjOdEJld8fDACJHT7u
-9JfM8jPsoXYALlUuX+8FKvwy6/DROsYPIs0Gy4sWEZWMEAz0tmNMPzo6XKBpcIkl
-ddbMBBgJm9upwzfDpamnQTJjIQk17qbFC0OOG6rbCnHRIXMDAcd91r1cc4M5Ssy/
-3r50vgASNFXYW50dU3JPztCl0M547sp5RkoYe8Gs+f5rDudaMM8JybFQ6EDGyBQK
-ng0EzOrzbKJ2CpEk1QmnLK9+7WkMit5U2whX7zY2sHSn3GY9EzC1dedcFf5Mk24S
-8/PkwiNYbdWJCAfbLkAsOZTjlrj3pEwVBU3CEPs+wVoUrJ6aaxqknFmjjD/NpuDD
-IiaJ0b3m264pGQcbOZHAVFSWjCkguNyJYsR1OFOtTgEMMguUH9m0gjLSCaoyDrgr
-Ebuw2kHFX3WjZXpWJ4z80athDgSeXcfK/RArvB8/J/mpJLuxzO/Mqohl18A72ZGO
-VNrQmXP3yq8mco0wkmfnR9WOpiD8Dvk6LyJQkWGYUzASZcwdrLAOm6CopJmJAjME
-EwEIAB0WIQT/qRKaGA12W3pb6hybQysn0bog1wUCZDb5bAAKCRCbQysn0bog1/UU
-D/48369bmijcpJLSaNvKdyFoqRvzWDtFUZPf/RfBf3CWUYS++HoBLTD1uJJxpDH2
-iMGvkOfC7yr/WeInzTkwUOw+8y8rPVi4Yo5dPDCLn8eYdse6zFdvHDlMOC6zTzS3
-Rf5eGvcfQ0RcKPS18zCAApbxrJyUVWB9e0z6d79TTXmAnt/js8QAnYxpbTsOeID4
-zK22L6YioFbhH1FCa2JC45fzcPuty5lDJ14/eAmkTw2q0nxlSD6/CsePdwVuwjy8
-s9Addkmr/lO8bzOigyHd9jvaEpNePhpKDqXB3z4PzR0zQbZZKCCEMwEt6i7yO+3Z
-ObqpJqDwvfbd7+EB1W05Pf/KJLLurQry5hg5DADqjrRFmaFXBSAGsk/fm+K3B+JI
-6XrPMzTw8VBAYGS6E5EWRtQzr5O69lM14hgURz6ERSTPI27Hip0tOpoViE0aFM6L
-wu/iv16uM4zEQq29Rm/u1zIAu7B6LJl6P4AVsh8vHmk+QmMTx18CvG4taNlo44g6
-uXzPjzM5/i2RcZkFRmZLSBHFEyS/cKT2xWVRMRURN7XQOQHj/nsdjQ08Ax7qHcgP
-wGpnWaq1V0o7qjAkJoj6yUlIxl0Uch03mv+EtXJXfbKhqK6Q4m6JZKh4ga48bRzp
-bgMgONX/TX5AKLWMWC4ktTBeiMuJeBeWTpnUZdckMHR1SbkBjQRkHen0AQwAqPr8
-Na/WLXHg2KJpqBKuA95OZt9qARdHFgRX/fCYntx0pTQsYhfe781Q1SdzpxqH/OGU
-kwSpp6Oj6a0LMG9wbsN3CjrncTZUIfqIUzXQG2hfYktpHMCsEeZUlDVcmCGWbA5S
-6669+lq7zrH/d2vkA9suod4gw41GvDawppfzZLATzgAomiqm/zOhfUdIv//KuErE
-HWNE6JQfhamTwwehbUGxHgmrg1hNnaUKNOGh7QBAgQJzKoAvcvVvLnO1nAkLVJ52
-ktJpB3SVyEpiwfDF8Y9HSZyQAYswFhtWBTAumLijy0FeOWcT5XHN5GqRKs9j6pky
-UAtpAin+8h6jwIkQo0zxmtemzanaZrJ0+Z/9WmEgB5VVo00+QZOb/QNbw9xV933e
-OlamUYCIEMSiWJil4cFRfSQUK6slsXG7dfxkjsHmsS30rP4qGEkJXS0Bt9ksNpL4
-yFKAv8NkNPP53Via7TRWi91zNL2LJIrqpTUxn7ykNK3RnbK1IY91q8FWVi3fABEB
-AAGJAbYEGAEIACAWIQSjiECfQdSJx3bOdd9VTGzjOdEJlQUCZB3p9AIbDAAKCRBV
-TGzjOdEJlSp6C/4mfaEA3rZJLonokkwtnVzN8sWEy/6LGJFSUF+AeqEEmF2L8wi0
-T6oSbz858VlmMgRVFnnwThTkBH2OYWqRCfaZttH1Hw7m2n409crjeN1Uzd6BxXfq
-xAnw5O4JpVIIOGipjxQLgNasGIrPw4NndVWQa/+cSNizpS3vKxzSBqisrjJk1kwe
-mDlIxoL8dmoazw5xPdKv2MD1LrKiPnmbUrBmLr5LUj5rdFE3o1HPDYgDS/HkCNQK
-+/e+zORPtFv3qQ87dVrL8nJaPN/bPzTJdllzdgicnGldpVNuHKk0fI3PwgcbZfz3
-YbqchZkVAd76smNZR4M3/WnspmpSyCiC2gLMunV5BzkUFdSfH6JKMEmjY49Q7ban
-MnI3QyongtXKBW4eP0zB5qs2AEUHUjBP+wP6lH9ybUPDADAiWdnLwtwrMt0oEKm+
-buAuLkKKI35T4Lz2U41GMVIMZ7drlhbfDGugk1qT1cXJPZbPnHt+uFNUY0pTJaf3
-WapxBp2/lKhgsIE=
-=ZWln
------END PGP PUBLIC KEY BLOCK-----
-pub   ed25519 2024-08-19 [SC] [expires: 2027-08-19]
-      E52F07877A5805F9AF4AB0ACD46C5610D06E7001
-uid           [ultimate] ICU Release Robot <icu-robot@unicode.org>
-sig 3        D46C5610D06E7001 2024-08-19  ICU Release Robot <icu-robot@unicode.org>
-sig 3        9B432B27D1BA20D7 2024-10-01  Fredrik Roubert <fredrik@roubert.name>
-sub   cv25519 2024-08-19 [E] [expires: 2027-08-19]
-sig          D46C5610D06E7001 2024-08-19  ICU Release Robot <icu-robot@unicode.org>
-
------BEGIN PGP PUBLIC KEY BLOCK-----
-
-mDMEZsO4fRYJKwYBBAHaRw8BAQdAcxaiyqFbaECpSz7mhsXzopzN9Cxwv80WlWGN
-gM3qpOi0KUlDVSBSZWxlYXNlIFJvYm90IDxpY3Utcm9ib3RAdW5pY29kZS5vcmc+
-iJkEExYKAEEWIQTlLweHelgF+a9KsKzUbFYQ0G5wAQUCZsO4fQIbAwUJBaOagAUL
-CQgHAgIiAgYVCgkICwIEFgIDAQIeBwIXgAAKCRDUbFYQ0G5wAQOWAQDs628TYI+F
-oTaJ1jzqC3ObL6we4tmrW7yHL1KFR5xrhwD/ZioLjdrIUQZhcjV9rNt7mYZm/Ttl
-hn06bx06eyjf0QyJAjMEEwEIAB0WIQT/qRKaGA12W3pb6hybQysn0bog1wUCZvwt
-VgAKCRCbQysn0bog11wED/0dUw/riFIixjNRAoi+beQ1f42dG/60xm7qquZ4kE3n
-KysN773YgE1Gl8wPsFBbAAznO++fJ2ONjTks4QNOV0MB5Rgv+LwK+5oOKDRA9LxE
-QRCRYtFpErbCirIn/EGVJJCCbF+SoUY8rJL+PTfzHGDkJGK0lQIA49rdWjV9PW06
-4FRufzNrg4jNMCsJ5i67mjSb267C7I2mcfM3xX2nqZdOFW+wdctm9fvAWpIMQEQ5
-6J0wIQxwtF3GulKqr/7EV0LSB0Is0E4BBjwROMFiSa53Va/lcfZaQ7nJ8flsAfkO
-gGmvGjM5VH1kO2hnhm5ps5x/fIqSbw7X2nYsUXjbL6YKHbUsbV1ybpS5XCnMcTyx
-t5zmWkfZNLZk4+Qh/td6MyW7OmycY2GazRpMA28pX6gQYFBcSoXHNFqIyXU7wFJp
-BmDqe8gbi7FIlewwS+XP5dWfNvP5EqfztO7p8hxB2O1ZIYxMKerr0Q6rvY9/fEL5
-fd7OMdK5OkQWASF7NjDFG9I50N4W/1+1jUHytp6bBtjjdnebp4a8gidP88HeGidk
-2ONV9Yf0hOZUpRfMEFxpLxd9Ker928iZpopXOZ7AipFcXghWrReyTMljqXYvzNtd
-qRdH3WmnNx4zw2K/JgAtOw9Domc09UzEFFHgAscUVXvsoVX4g8soZnBWomtlCfU0
-/bg4BGbDuH0SCisGAQQBl1UBBQEBB0Djm2qMxtO+jO+uhT5nScClgbSvdmWAjbt9
-RIQh60+4QgMBCAeIfgQYFgoAJhYhBOUvB4d6WAX5r0qwrNRsVhDQbnABBQJmw7h9
-AhsMBQkFo5qAAAoJENRsVhDQbnABkbUA/ib52SlXmDcBPsvCz+VQOsqt1OvmeioS
-NSbBQ0KK5M66AQCs7zuWUQQhptmeB2l7sP528nsFv4FJfZ/McqauwTq7Bg==
-=BHrV
------END PGP PUBLIC KEY BLOCK-----`
-)
+import _ "embed"
+
+// icuPublicKeyBlock is the contents of
+// https://github.com/unicode-org/icu/blob/main/KEYS on 2023-4-14. It is the
+// default keyring of the Verifier; keys are rotated by replacing the KEYS
+// file, or by loading a keyring from elsewhere with --keyring.
+//
+//go:embed KEYS
+var icuPublicKeyBlock string
@@ -0,0 +1,193 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The identifiers of the provenance statements that the retrieval writes,
+// which follow the in-toto Statement v1 and SLSA Provenance v1 formats.
+const (
+	ProvenanceStatementType = "https://in-toto.io/Statement/v1"
+	ProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	ProvenanceBuildType     = "https://github.com/paketo-buildpacks/icu/dependency/retrieval@v1"
+	ProvenanceBuilderID     = "https://github.com/paketo-buildpacks/icu/dependency/retrieval"
+)
+
+// ProvenanceStatement records where the source of a version was retrieved
+// from and how it was verified, so that the artifacts compiled from it can
+// carry that record.
+type ProvenanceStatement struct {
+	Type          string               `json:"_type"`
+	Subject       []ResourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     ProvenancePredicate  `json:"predicate"`
+}
+
+// ResourceDescriptor is a file of a release, by its URL and digests. The
+// annotations of the file that the source was verified with hold the result
+// of that verification.
+type ResourceDescriptor struct {
+	Name        string              `json:"name,omitempty"`
+	URI         string              `json:"uri,omitempty"`
+	Digest      map[string]string   `json:"digest,omitempty"`
+	Annotations *VerificationResult `json:"annotations,omitempty"`
+}
+
+type ProvenancePredicate struct {
+	BuildDefinition ProvenanceBuildDefinition `json:"buildDefinition"`
+	RunDetails      ProvenanceRunDetails      `json:"runDetails"`
+}
+
+type ProvenanceBuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   ProvenanceParameters `json:"externalParameters"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies"`
+}
+
+type ProvenanceParameters struct {
+	Version string `json:"version"`
+	Policy  string `json:"policy"`
+}
+
+type ProvenanceRunDetails struct {
+	Builder  ProvenanceBuilder  `json:"builder"`
+	Metadata ProvenanceMetadata `json:"metadata"`
+}
+
+type ProvenanceBuilder struct {
+	ID string `json:"id"`
+}
+
+// ProvenanceMetadata holds the time at which the source was verified.
+type ProvenanceMetadata struct {
+	FinishedOn time.Time `json:"finishedOn"`
+}
+
+// Provenance returns the provenance statement of the source of the given
+// version, once metadata has been generated for it. The resolved dependencies
+// are the source, the signature or attestation bundle that it was verified
+// with, and the checksum file along with its signature when it has one.
+func (g Generator) Provenance(version string) (ProvenanceStatement, bool) {
+	if g.verifications == nil {
+		return ProvenanceStatement{}, false
+	}
+
+	g.verifications.mutex.Lock()
+	verification, ok := g.verifications.byVersion[version]
+	g.verifications.mutex.Unlock()
+
+	if !ok {
+		return ProvenanceStatement{}, false
+	}
+
+	files := verification.files
+	source := resourceDescriptor(files.Source, verification.checksums)
+
+	// Results recorded before verification methods were recorded come from
+	// PGP signatures.
+	evidence := files.Signature
+	if verification.result.Method == PolicyAttestation {
+		evidence = files.Attestation
+	}
+
+	result := verification.result
+	signature := resourceDescriptor(evidence, verification.checksums)
+	signature.Annotations = &result
+
+	dependencies := []ResourceDescriptor{source, signature, resourceDescriptor(files.Shasum512, verification.checksums)}
+	if files.Shasum512Signature != (ReleaseFile{}) {
+		dependencies = append(dependencies, resourceDescriptor(files.Shasum512Signature, verification.checksums))
+	}
+
+	return ProvenanceStatement{
+		Type:          ProvenanceStatementType,
+		Subject:       []ResourceDescriptor{source},
+		PredicateType: ProvenancePredicateType,
+		Predicate: ProvenancePredicate{
+			BuildDefinition: ProvenanceBuildDefinition{
+				BuildType: ProvenanceBuildType,
+				ExternalParameters: ProvenanceParameters{
+					Version: version,
+					Policy:  g.Policy,
+				},
+				ResolvedDependencies: dependencies,
+			},
+			RunDetails: ProvenanceRunDetails{
+				Builder:  ProvenanceBuilder{ID: ProvenanceBuilderID},
+				Metadata: ProvenanceMetadata{FinishedOn: result.VerifiedAt},
+			},
+		},
+	}, true
+}
+
+// WriteProvenance writes the provenance statement of every version that
+// metadata has been generated for to icu-<version>.intoto.json in the given
+// directory, and returns the paths of the files.
+func (g Generator) WriteProvenance(dir string) ([]string, error) {
+	if g.verifications == nil {
+		return nil, nil
+	}
+
+	g.verifications.mutex.Lock()
+	var versions []string
+	for version := range g.verifications.byVersion {
+		versions = append(versions, version)
+	}
+	g.verifications.mutex.Unlock()
+
+	sort.Strings(versions)
+
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provenance directory: %w", err)
+	}
+
+	var paths []string
+	for _, version := range versions {
+		statement, _ := g.Provenance(version)
+
+		content, err := json.MarshalIndent(statement, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal provenance of %s: %w", version, err)
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("icu-%s.intoto.json", version))
+		err = os.WriteFile(path, content, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write provenance of %s: %w", version, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// resourceDescriptor describes a release file by the digest that the release
+// reports for it, and by its entry in the checksum file when it has one.
+func resourceDescriptor(file ReleaseFile, checksums map[string]string) ResourceDescriptor {
+	digest := map[string]string{}
+	if algorithm, value, ok := strings.Cut(file.Digest, ":"); ok {
+		digest[strings.ToLower(algorithm)] = strings.ToLower(value)
+	}
+
+	if checksum, ok := checksums[file.Name]; ok {
+		digest["sha512"] = checksum
+	}
+
+	if len(digest) == 0 {
+		digest = nil
+	}
+
+	return ResourceDescriptor{
+		Name:   file.Name,
+		URI:    file.URL,
+		Digest: digest,
+	}
+}
@@ -0,0 +1,99 @@
+package components
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// ReleaseKind classifies an ICU release. Every major version starts with a GA
+// release, X.1 since ICU 49 and X.Y before, which is followed by maintenance
+// releases such as 74.2 or 4.8.1.
+type ReleaseKind string
+
+const (
+	ReleaseKindGA          ReleaseKind = "ga"
+	ReleaseKindRC          ReleaseKind = "rc"
+	ReleaseKindMaintenance ReleaseKind = "maintenance"
+)
+
+// firstTwoPartVersion is the first major version of ICU that is versioned as
+// major.minor, with the GA release of every major version being major.1.
+const firstTwoPartVersion = 49
+
+var (
+	// tags are named release-74-2, release-4-8-1, release-74-rc or
+	// release-74-1-rc
+	releaseTagPattern = regexp.MustCompile(`(?i)^release-(\d+(?:-\d+)*)(?:-(rc|preview|alpha|beta)\d*)?$`)
+
+	// names are ICU 74.2, ICU 74 RC, ICU4C 49.1.2 or ICU 4C 49.1.2
+	releaseNamePattern = regexp.MustCompile(`(?i)^icu\s*(?:4c\s+)?(\d+(?:\.\d+)*)(?:\s*[-.]?\s*(rc|release candidate|preview|alpha|beta)\s*\d*)?$`)
+)
+
+// ParseReleaseVersion derives the version of a GitHub release of ICU from its
+// tag name and falls back to its name. Candidate releases are returned with an
+// rc prerelease, such as 74.0.0-rc. An error is returned when neither the tag
+// name nor the name can be parsed, when they disagree, or when the version has
+// more parts than a semantic version, such as 4.8.1.1.
+func ParseReleaseVersion(tagName, name string) (string, *semver.Version, error) {
+	tagVersion, tagCandidate, tagOk := matchReleaseVersion(releaseTagPattern, strings.TrimSpace(tagName), "-")
+	nameVersion, nameCandidate, nameOk := matchReleaseVersion(releaseNamePattern, strings.TrimSpace(name), ".")
+
+	var (
+		version   string
+		candidate bool
+	)
+
+	switch {
+	case tagOk && nameOk && (tagVersion != nameVersion || tagCandidate != nameCandidate):
+		return "", nil, fmt.Errorf("the tag name %q and the name %q refer to different versions", tagName, name)
+	case tagOk:
+		version, candidate = tagVersion, tagCandidate
+	case nameOk:
+		version, candidate = nameVersion, nameCandidate
+	default:
+		return "", nil, fmt.Errorf("neither the tag name %q nor the name %q contain an ICU version", tagName, name)
+	}
+
+	if strings.Count(version, ".") > 2 {
+		return "", nil, fmt.Errorf("the version %q has more parts than a semantic version", version)
+	}
+
+	semverString := version
+	if candidate {
+		semverString = fmt.Sprintf("%s-rc", version)
+	}
+
+	semVer, err := semver.NewVersion(semverString)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: the following version string could not be parsed %q", err, semverString)
+	}
+
+	return version, semVer, nil
+}
+
+func matchReleaseVersion(pattern *regexp.Regexp, value, separator string) (string, bool, bool) {
+	match := pattern.FindStringSubmatch(value)
+	if match == nil {
+		return "", false, false
+	}
+
+	return strings.ReplaceAll(match[1], separator, "."), match[2] != "", true
+}
+
+// Kind classifies the release by its version.
+func (icuRelease IcuRelease) Kind() ReleaseKind {
+	version := icuRelease.SemVer
+	switch {
+	case version.Prerelease() != "":
+		return ReleaseKindRC
+	case version.Major() >= firstTwoPartVersion && version.Minor() <= 1 && version.Patch() == 0:
+		return ReleaseKindGA
+	case version.Major() < firstTwoPartVersion && version.Patch() == 0:
+		return ReleaseKindGA
+	default:
+		return ReleaseKindMaintenance
+	}
+}
@@ -0,0 +1,86 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testReleaseVersion(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+	)
+
+	context("ParseReleaseVersion", func() {
+		for _, example := range []struct {
+			tagName string
+			name    string
+			version string
+			semver  string
+		}{
+			{tagName: "release-74-2", name: "ICU 74.2", version: "74.2", semver: "74.2.0"},
+			{tagName: "release-78-1", name: "", version: "78.1", semver: "78.1.0"},
+			{tagName: "", name: "ICU 72.1", version: "72.1", semver: "72.1.0"},
+			{tagName: "release-49-1-2", name: "ICU 4C 49.1.2", version: "49.1.2", semver: "49.1.2"},
+			{tagName: "latest", name: "ICU4C 49.1.2", version: "49.1.2", semver: "49.1.2"},
+			{tagName: "release-4-8-1", name: "ICU 4.8.1", version: "4.8.1", semver: "4.8.1"},
+			{tagName: "release-74-rc", name: "ICU 74 RC", version: "74", semver: "74.0.0-rc"},
+			{tagName: "release-74-1-rc", name: "ICU 74.1 Release Candidate", version: "74.1", semver: "74.1.0-rc"},
+		} {
+			example := example
+
+			it(example.tagName+" "+example.name, func() {
+				version, semVer, err := components.ParseReleaseVersion(example.tagName, example.name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(version).To(Equal(example.version))
+				Expect(semVer.String()).To(Equal(example.semver))
+			})
+		}
+
+		context("failure cases", func() {
+			context("when neither the tag name nor the name contain a version", func() {
+				it("returns an error", func() {
+					_, _, err := components.ParseReleaseVersion("cldr/2022-10-11", "cldr/2022-10-11")
+					Expect(err).To(MatchError(`neither the tag name "cldr/2022-10-11" nor the name "cldr/2022-10-11" contain an ICU version`))
+				})
+			})
+
+			context("when the tag name and the name disagree", func() {
+				it("returns an error", func() {
+					_, _, err := components.ParseReleaseVersion("release-74-1", "ICU 74 RC")
+					Expect(err).To(MatchError(`the tag name "release-74-1" and the name "ICU 74 RC" refer to different versions`))
+				})
+			})
+
+			context("when the version has more than three parts", func() {
+				it("returns an error", func() {
+					_, _, err := components.ParseReleaseVersion("release-4-8-1-1", "ICU 4.8.1.1")
+					Expect(err).To(MatchError(`the version "4.8.1.1" has more parts than a semantic version`))
+				})
+			})
+		})
+	})
+
+	context("Kind", func() {
+		for version, kind := range map[string]components.ReleaseKind{
+			"74.1.0-rc": components.ReleaseKindRC,
+			"74.1":      components.ReleaseKindGA,
+			"49.1":      components.ReleaseKindGA,
+			"74.2":      components.ReleaseKindMaintenance,
+			"49.1.2":    components.ReleaseKindMaintenance,
+			"4.8":       components.ReleaseKindGA,
+			"4.8.1":     components.ReleaseKindMaintenance,
+		} {
+			version, kind := version, kind
+
+			it(version, func() {
+				release := components.IcuRelease{SemVer: semver.MustParse(version)}
+				Expect(release.Kind()).To(Equal(kind))
+			})
+		}
+	})
+}
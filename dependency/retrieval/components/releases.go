@@ -3,26 +3,58 @@ package components
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/paketo-buildpacks/libdependency/versionology"
 )
 
+const (
+	defaultRepository = "unicode-org/icu"
+	defaultRetries    = 5
+
+	defaultInitialBackoff = time.Second
+	defaultMaximumBackoff = time.Hour
+)
+
+var linkNextPattern = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="next"`)
+
 type IcuRelease struct {
 	SemVer         *semver.Version
 	ReleaseVersion string
 	Files          []ReleaseFile
 }
 
+// ReleaseFile is a file of a release. The digest is the "<algorithm>:<hex>"
+// digest that the GitHub release asset API reports, when it reports one.
 type ReleaseFile struct {
-	Name string `json:"name"`
-	URL  string `json:"browser_download_url"`
+	Name   string `json:"name"`
+	URL    string `json:"browser_download_url"`
+	Digest string `json:"digest,omitempty"`
 }
 
+// Fetcher lists the ICU releases of a GitHub repository. Requests are
+// authenticated with GITHUB_TOKEN when it is set, pages are followed through
+// the Link header, and rate-limited or failing requests are retried after the
+// time given by Retry-After or X-RateLimit-Reset, or with exponential backoff.
 type Fetcher struct {
-	api string
+	api        string
+	repository string
+	token      string
+
+	client    *http.Client
+	logWriter io.Writer
+
+	retries        int
+	initialBackoff time.Duration
+	maximumBackoff time.Duration
 }
 
 func (icuRelease IcuRelease) Version() *semver.Version {
@@ -31,7 +63,14 @@ func (icuRelease IcuRelease) Version() *semver.Version {
 
 func NewFetcher() Fetcher {
 	return Fetcher{
-		api: "https://api.github.com",
+		api:            "https://api.github.com",
+		repository:     defaultRepository,
+		token:          os.Getenv("GITHUB_TOKEN"),
+		client:         http.DefaultClient,
+		logWriter:      os.Stderr,
+		retries:        defaultRetries,
+		initialBackoff: defaultInitialBackoff,
+		maximumBackoff: defaultMaximumBackoff,
 	}
 }
 
@@ -40,53 +79,232 @@ func (f Fetcher) WithAPI(uri string) Fetcher {
 	return f
 }
 
+// WithEnterpriseURL points the fetcher at the REST API of a GitHub Enterprise
+// Server, given its base URL such as https://github.example.com.
+func (f Fetcher) WithEnterpriseURL(uri string) Fetcher {
+	f.api = fmt.Sprintf("%s/api/v3", strings.TrimSuffix(uri, "/"))
+	return f
+}
+
+// WithRepository sets the owner/name slug of the repository whose releases
+// are listed.
+func (f Fetcher) WithRepository(slug string) Fetcher {
+	f.repository = slug
+	return f
+}
+
+func (f Fetcher) WithToken(token string) Fetcher {
+	f.token = token
+	return f
+}
+
+func (f Fetcher) WithLogWriter(writer io.Writer) Fetcher {
+	f.logWriter = writer
+	return f
+}
+
+// WithRetries sets how many times a rate-limited or failing request is
+// retried.
+func (f Fetcher) WithRetries(retries int) Fetcher {
+	f.retries = retries
+	return f
+}
+
+// WithBackoff sets the first wait of the exponential backoff and the longest
+// time to wait before a retry, which also caps the waits requested by the
+// server.
+func (f Fetcher) WithBackoff(initial, maximum time.Duration) Fetcher {
+	f.initialBackoff = initial
+	f.maximumBackoff = maximum
+	return f
+}
+
+type githubRelease struct {
+	TagName    string        `json:"tag_name"`
+	Name       string        `json:"name"`
+	Draft      bool          `json:"draft"`
+	Prerelease bool          `json:"prerelease"`
+	Assets     []ReleaseFile `json:"assets"`
+}
+
+// GetIcuVersions lists the GA and maintenance releases of the repository.
+// Releases whose version cannot be parsed are skipped and listed in a warning
+// once all pages have been fetched.
 func (f Fetcher) GetIcuVersions() (versionology.VersionFetcherArray, error) {
 	page := 1
-	var releases versionology.VersionFetcherArray
-	for {
-		resp, err := http.Get(fmt.Sprintf("%s/repos/unicode-org/icu/releases?per_page=100&page=%d", f.api, page))
+	next := fmt.Sprintf("%s/repos/%s/releases?per_page=100&page=%d", f.api, f.repository, page)
+
+	var (
+		releases versionology.VersionFetcherArray
+		skipped  []skippedRelease
+	)
+	for next != "" {
+		releaseResponse, link, err := f.getReleases(next)
 		if err != nil {
 			return nil, err
 		}
 
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return nil, fmt.Errorf("received a non 200 status code: status code %d received", resp.StatusCode)
-		}
+		// Pages are followed through the Link header. Servers that do not send
+		// one are paged until they return an empty page.
+		switch {
+		case link != "":
+			next = ""
+			if match := linkNextPattern.FindStringSubmatch(link); match != nil {
+				next = match[1]
+			}
 
-		var releaseResponse []struct {
-			TagName    string        `json:"tag_name"`
-			Name       string        `json:"name"`
-			Draft      bool          `json:"draft"`
-			Prerelease bool          `json:"prerelease"`
-			Assets     []ReleaseFile `json:"assets"`
-		}
-		err = json.NewDecoder(resp.Body).Decode(&releaseResponse)
-		if err != nil {
-			return nil, err
-		}
+		case len(releaseResponse) == 0:
+			next = ""
 
-		if len(releaseResponse) == 0 {
-			break
+		default:
+			page++
+			next = fmt.Sprintf("%s/repos/%s/releases?per_page=100&page=%d", f.api, f.repository, page)
 		}
 
-		page++
-
 		for _, release := range releaseResponse {
 			if release.Draft || release.Prerelease {
 				continue
 			}
 
 			var r IcuRelease
-			r.ReleaseVersion = strings.TrimPrefix(release.Name, "ICU ") // The space is important
-			r.SemVer, err = semver.NewVersion(r.ReleaseVersion)
+			r.ReleaseVersion, r.SemVer, err = ParseReleaseVersion(release.TagName, release.Name)
 			if err != nil {
-				return nil, fmt.Errorf("%w: the following version string could not be parsed %q", err, r.ReleaseVersion)
+				skipped = append(skipped, skippedRelease{TagName: release.TagName, Name: release.Name, Reason: err.Error()})
+				continue
 			}
 			r.Files = release.Assets
 
+			// candidates that are not marked as prereleases are left out as well
+			if r.Kind() == ReleaseKindRC {
+				continue
+			}
+
 			releases = append(releases, r)
 		}
 	}
 
+	if len(skipped) > 0 {
+		fmt.Fprintf(f.logWriter, "Warning: skipped %d release(s) whose version could not be parsed:\n", len(skipped))
+		for _, release := range skipped {
+			fmt.Fprintf(f.logWriter, "  tag_name=%q name=%q reason=%q\n", release.TagName, release.Name, release.Reason)
+		}
+	}
+
 	return releases, nil
 }
+
+type skippedRelease struct {
+	TagName string
+	Name    string
+	Reason  string
+}
+
+// getReleases fetches a page of releases and returns it along with the Link
+// header of the response.
+func (f Fetcher) getReleases(uri string) ([]githubRelease, string, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, uri, nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		req.Header.Set("Accept", "application/vnd.github+json")
+		if f.token != "" && f.sameHost(req.URL) {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", f.token))
+		}
+
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			var releases []githubRelease
+			err = json.NewDecoder(resp.Body).Decode(&releases)
+			resp.Body.Close()
+			if err != nil {
+				return nil, "", err
+			}
+
+			return releases, resp.Header.Get("Link"), nil
+		}
+
+		resp.Body.Close()
+
+		wait, retryable := f.retryAfter(resp, attempt)
+		if !retryable || attempt >= f.retries {
+			if rateLimited(resp) {
+				return nil, "", fmt.Errorf("received a non 200 status code: status code %d received: the GitHub API rate limit is exceeded, set GITHUB_TOKEN to raise it", resp.StatusCode)
+			}
+
+			return nil, "", fmt.Errorf("received a non 200 status code: status code %d received", resp.StatusCode)
+		}
+
+		fmt.Fprintf(f.logWriter, "Received status code %d from %s, retrying in %s (attempt %d of %d)\n", resp.StatusCode, req.URL.Redacted(), wait, attempt+1, f.retries)
+		time.Sleep(wait)
+	}
+}
+
+// retryAfter returns how long to wait before retrying the request, and
+// whether it should be retried at all. Rate-limited requests wait for the time
+// given by the server; server errors back off exponentially.
+func (f Fetcher) retryAfter(resp *http.Response, attempt int) (time.Duration, bool) {
+	backoff := f.initialBackoff << attempt
+	if backoff <= 0 || backoff > f.maximumBackoff {
+		backoff = f.maximumBackoff
+	}
+
+	switch {
+	case rateLimited(resp):
+		wait := backoff
+
+		if value := resp.Header.Get("Retry-After"); value != "" {
+			if seconds, err := strconv.Atoi(value); err == nil {
+				wait = time.Duration(seconds) * time.Second
+			} else if date, err := http.ParseTime(value); err == nil {
+				wait = time.Until(date)
+			}
+		} else if value := resp.Header.Get("X-RateLimit-Reset"); value != "" {
+			if reset, err := strconv.ParseInt(value, 10, 64); err == nil {
+				wait = time.Until(time.Unix(reset, 0))
+			}
+		}
+
+		if wait < 0 {
+			wait = 0
+		}
+
+		if wait > f.maximumBackoff {
+			wait = f.maximumBackoff
+		}
+
+		return wait, true
+
+	case resp.StatusCode >= 500:
+		return backoff, true
+	}
+
+	return 0, false
+}
+
+// rateLimited reports whether the response is a primary or secondary rate
+// limit of the GitHub API.
+func rateLimited(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusForbidden &&
+		(resp.Header.Get("Retry-After") != "" || resp.Header.Get("X-RateLimit-Remaining") == "0")
+}
+
+// sameHost reports whether the request goes to the configured API, so that
+// the token is not sent elsewhere.
+func (f Fetcher) sameHost(uri *url.URL) bool {
+	api, err := url.Parse(f.api)
+	if err != nil {
+		return false
+	}
+
+	return api.Host == uri.Host
+}
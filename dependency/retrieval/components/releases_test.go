@@ -1,10 +1,15 @@
 package components_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
@@ -96,6 +101,11 @@ func testReleases(t *testing.T, context spec.G, it spec.S) {
 
 				case "/bad-version/repos/unicode-org/icu/releases":
 					w.WriteHeader(http.StatusOK)
+					if req.URL.RawQuery != "per_page=100&page=1" {
+						fmt.Fprintln(w, `[]`)
+						return
+					}
+
 					fmt.Fprintln(w, `[
   {
     "name": "ICU invalid version"
@@ -110,6 +120,24 @@ func testReleases(t *testing.T, context spec.G, it spec.S) {
 			fetcher = components.NewFetcher().WithAPI(server.URL)
 		})
 
+		context("when the version is unparsable", func() {
+			var logs *bytes.Buffer
+
+			it.Before(func() {
+				logs = bytes.NewBuffer(nil)
+				fetcher = fetcher.WithAPI(fmt.Sprintf("%s/bad-version", server.URL)).WithLogWriter(logs)
+			})
+
+			it("skips the release and reports it", func() {
+				releases, err := fetcher.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(releases).To(BeEmpty())
+
+				Expect(logs.String()).To(ContainSubstring("Warning: skipped 1 release(s) whose version could not be parsed:\n"))
+				Expect(logs.String()).To(ContainSubstring(`  tag_name="" name="ICU invalid version" reason="neither the tag name \"\" nor the name \"ICU invalid version\" contain an ICU version"`))
+			})
+		})
+
 		it("fetches a list of relevant releases", func() {
 			releases, err := fetcher.GetIcuVersions()
 			Expect(err).NotTo(HaveOccurred())
@@ -172,14 +200,220 @@ func testReleases(t *testing.T, context spec.G, it spec.S) {
 				})
 			})
 
-			context("when the version is unparsable", func() {
+		})
+	})
+
+	context("when the releases are listed through the GitHub API", func() {
+		var (
+			fetcher components.Fetcher
+			server  *httptest.Server
+			logs    *bytes.Buffer
+
+			mutex    sync.Mutex
+			requests []*http.Request
+			handlers []http.HandlerFunc
+		)
+
+		release := func(version string) string {
+			return fmt.Sprintf(`{"tag_name": "release-%s", "name": "ICU %s", "assets": [{"name": "icu4c-%s-sources.tgz", "browser_download_url": "https://example.com/icu4c-%s-sources.tgz"}]}`,
+				strings.ReplaceAll(version, ".", "-"), version, version, version)
+		}
+
+		it.Before(func() {
+			logs = bytes.NewBuffer(nil)
+			requests = nil
+			handlers = nil
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				mutex.Lock()
+				requests = append(requests, req)
+				var handler http.HandlerFunc
+				if len(handlers) > 0 {
+					handler, handlers = handlers[0], handlers[1:]
+				}
+				mutex.Unlock()
+
+				if handler == nil {
+					t.Fatalf("unexpected request: %s", req.URL)
+				}
+
+				handler(w, req)
+			}))
+
+			fetcher = components.NewFetcher().
+				WithAPI(server.URL).
+				WithToken("some-token").
+				WithLogWriter(logs).
+				WithBackoff(time.Millisecond, 10*time.Millisecond)
+		})
+
+		it.After(func() {
+			server.Close()
+		})
+
+		context("when the responses link to the next page", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{
+					func(w http.ResponseWriter, req *http.Request) {
+						w.Header().Set("Link", fmt.Sprintf(`<%s/repositories/1234/releases?per_page=100&page=2>; rel="next", <%s/repositories/1234/releases?per_page=100&page=2>; rel="last"`, server.URL, server.URL))
+						fmt.Fprintf(w, "[%s]", release("78.3"))
+					},
+					func(w http.ResponseWriter, req *http.Request) {
+						w.Header().Set("Link", fmt.Sprintf(`<%s/repositories/1234/releases?per_page=100&page=1>; rel="first"`, server.URL))
+						fmt.Fprintf(w, "[%s]", release("77.1"))
+					},
+				}
+			})
+
+			it("follows the links with the token", func() {
+				releases, err := fetcher.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(releases).To(HaveLen(2))
+				Expect(releases[0].Version().String()).To(Equal("78.3.0"))
+				Expect(releases[1].Version().String()).To(Equal("77.1.0"))
+
+				Expect(requests).To(HaveLen(2))
+				Expect(requests[0].URL.String()).To(Equal("/repos/unicode-org/icu/releases?per_page=100&page=1"))
+				Expect(requests[1].URL.String()).To(Equal("/repositories/1234/releases?per_page=100&page=2"))
+				for _, req := range requests {
+					Expect(req.Header.Get("Authorization")).To(Equal("Bearer some-token"))
+					Expect(req.Header.Get("Accept")).To(Equal("application/vnd.github+json"))
+				}
+			})
+		})
+
+		context("when the repository and GitHub Enterprise URL are configured", func() {
+			it.Before(func() {
+				fetcher = fetcher.WithEnterpriseURL(server.URL + "/").WithRepository("some-org/icu-mirror")
+				handlers = []http.HandlerFunc{func(w http.ResponseWriter, req *http.Request) {
+					fmt.Fprint(w, "[]")
+				}}
+			})
+
+			it("lists the releases of that repository", func() {
+				_, err := fetcher.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(requests).To(HaveLen(1))
+				Expect(requests[0].URL.Path).To(Equal("/api/v3/repos/some-org/icu-mirror/releases"))
+			})
+		})
+
+		context("when the rate limit is exceeded", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{
+					func(w http.ResponseWriter, req *http.Request) {
+						w.Header().Set("X-RateLimit-Remaining", "0")
+						w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+						w.WriteHeader(http.StatusForbidden)
+					},
+					func(w http.ResponseWriter, req *http.Request) {
+						w.Header().Set("Retry-After", "0")
+						w.WriteHeader(http.StatusTooManyRequests)
+					},
+					func(w http.ResponseWriter, req *http.Request) {
+						fmt.Fprintf(w, "[%s]", release("78.3"))
+					},
+					func(w http.ResponseWriter, req *http.Request) {
+						fmt.Fprint(w, "[]")
+					},
+				}
+			})
+
+			it("waits for the rate limit to reset and retries", func() {
+				releases, err := fetcher.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(releases).To(HaveLen(1))
+
+				Expect(requests).To(HaveLen(4))
+				Expect(logs.String()).To(ContainSubstring("Received status code 403 from %s/repos/unicode-org/icu/releases?per_page=100&page=1, retrying in 10ms (attempt 1 of 5)", server.URL))
+				Expect(logs.String()).To(ContainSubstring("Received status code 429 from %s/repos/unicode-org/icu/releases?per_page=100&page=1, retrying in 0s (attempt 2 of 5)", server.URL))
+			})
+		})
+
+		context("when some releases are named inconsistently", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{
+					func(w http.ResponseWriter, req *http.Request) {
+						fmt.Fprint(w, `[
+  {"tag_name": "release-74-rc", "name": "ICU 74 RC"},
+  {"tag_name": "release-74-2", "name": "ICU 74.2"},
+  {"tag_name": "release-4-8-1-1", "name": "ICU 4.8.1.1"},
+  {"tag_name": "latest", "name": "ICU4C 49.1.2"},
+  {"tag_name": "release-73-1", "name": "ICU 73.2"}
+]`)
+					},
+					func(w http.ResponseWriter, req *http.Request) {
+						fmt.Fprint(w, "[]")
+					},
+				}
+			})
+
+			it("lists the releases that can be parsed and reports the others", func() {
+				releases, err := fetcher.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(releases).To(HaveLen(2))
+				Expect(releases[0].(components.IcuRelease).ReleaseVersion).To(Equal("74.2"))
+				Expect(releases[0].(components.IcuRelease).Kind()).To(Equal(components.ReleaseKindMaintenance))
+				Expect(releases[1].(components.IcuRelease).ReleaseVersion).To(Equal("49.1.2"))
+				Expect(releases[1].(components.IcuRelease).Kind()).To(Equal(components.ReleaseKindMaintenance))
+
+				Expect(logs.String()).To(ContainSubstring("Warning: skipped 2 release(s) whose version could not be parsed:\n"))
+				Expect(logs.String()).To(ContainSubstring(`  tag_name="release-4-8-1-1" name="ICU 4.8.1.1" reason="the version \"4.8.1.1\" has more parts than a semantic version"`))
+				Expect(logs.String()).To(ContainSubstring(`  tag_name="release-73-1" name="ICU 73.2" reason="the tag name \"release-73-1\" and the name \"ICU 73.2\" refer to different versions"`))
+			})
+		})
+
+		context("when the server fails temporarily", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{
+					func(w http.ResponseWriter, req *http.Request) { w.WriteHeader(http.StatusBadGateway) },
+					func(w http.ResponseWriter, req *http.Request) { w.WriteHeader(http.StatusServiceUnavailable) },
+					func(w http.ResponseWriter, req *http.Request) { fmt.Fprint(w, "[]") },
+				}
+			})
+
+			it("retries with exponential backoff", func() {
+				_, err := fetcher.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(requests).To(HaveLen(3))
+				Expect(logs.String()).To(ContainSubstring("retrying in 1ms (attempt 1 of 5)"))
+				Expect(logs.String()).To(ContainSubstring("retrying in 2ms (attempt 2 of 5)"))
+			})
+		})
+
+		context("failure cases", func() {
+			context("when the rate limit does not reset within the retries", func() {
 				it.Before(func() {
-					fetcher = fetcher.WithAPI(fmt.Sprintf("%s/bad-version", server.URL))
+					fetcher = fetcher.WithRetries(1)
+					rateLimited := func(w http.ResponseWriter, req *http.Request) {
+						w.Header().Set("X-RateLimit-Remaining", "0")
+						w.WriteHeader(http.StatusForbidden)
+					}
+					handlers = []http.HandlerFunc{rateLimited, rateLimited}
 				})
 
 				it("returns an error", func() {
 					_, err := fetcher.GetIcuVersions()
-					Expect(err).To(MatchError(ContainSubstring("invalid semantic version")))
+					Expect(err).To(MatchError("received a non 200 status code: status code 403 received: the GitHub API rate limit is exceeded, set GITHUB_TOKEN to raise it"))
+					Expect(requests).To(HaveLen(2))
+				})
+			})
+
+			context("when the request is forbidden for another reason", func() {
+				it.Before(func() {
+					handlers = []http.HandlerFunc{func(w http.ResponseWriter, req *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+					}}
+				})
+
+				it("does not retry", func() {
+					_, err := fetcher.GetIcuVersions()
+					Expect(err).To(MatchError("received a non 200 status code: status code 403 received"))
+					Expect(requests).To(HaveLen(1))
 				})
 			})
 		})
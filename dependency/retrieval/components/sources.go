@@ -0,0 +1,329 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/paketo-buildpacks/libdependency/versionology"
+)
+
+// VersionSource lists the ICU releases that metadata can be generated for.
+type VersionSource interface {
+	GetIcuVersions() (versionology.VersionFetcherArray, error)
+}
+
+var (
+	_ VersionSource = Fetcher{}
+	_ VersionSource = UnicodeIndex{}
+	_ VersionSource = MirrorManifest{}
+)
+
+var (
+	hrefPattern = regexp.MustCompile(`(?i)href\s*=\s*["']([^"']+)["']`)
+
+	// the source tarballs are named icu4c-72_1-src.tgz up to ICU 77 and
+	// icu4c-78.1-sources.tgz since ICU 78
+	sourceFilePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`^icu4c-(\d+(?:_\d+)+)-src\.tgz$`),
+		regexp.MustCompile(`^icu4c-(\d+(?:\.\d+)+)-sources\.tgz$`),
+	}
+)
+
+// releaseIndex is the JSON format of the unicode.org download index and of
+// mirror manifests. URLs of files may be relative to the base URL, which
+// defaults to the location of the index.
+type releaseIndex struct {
+	BaseURL  string `json:"base-url"`
+	Releases []struct {
+		Version string `json:"version"`
+		Files   []struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"files"`
+	} `json:"releases"`
+}
+
+// icuReleases returns the releases of the index. Releases whose version
+// cannot be parsed are skipped and listed in a warning.
+func (index releaseIndex) icuReleases(location *url.URL, logWriter io.Writer) (versionology.VersionFetcherArray, error) {
+	base := location
+	if index.BaseURL != "" {
+		var err error
+		base, err = url.Parse(index.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse base-url %q: %w", index.BaseURL, err)
+		}
+	}
+
+	var (
+		releases versionology.VersionFetcherArray
+		skipped  []skippedVersion
+	)
+	for _, release := range index.Releases {
+		version, err := semver.NewVersion(release.Version)
+		if err != nil {
+			skipped = append(skipped, skippedVersion{Version: release.Version, Reason: err.Error()})
+			continue
+		}
+
+		r := IcuRelease{SemVer: version, ReleaseVersion: release.Version}
+		for _, file := range release.Files {
+			uri, err := base.Parse(file.URL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the URL of %s: %w", file.Name, err)
+			}
+
+			name := file.Name
+			if name == "" {
+				name = path.Base(uri.Path)
+			}
+
+			r.Files = append(r.Files, ReleaseFile{Name: name, URL: uri.String()})
+		}
+
+		releases = append(releases, r)
+	}
+
+	logSkippedVersions(logWriter, skipped)
+
+	return releases, nil
+}
+
+type skippedVersion struct {
+	Version string
+	Reason  string
+}
+
+// logSkippedVersions writes a warning that lists the skipped releases, in the
+// same way as the GitHub source does.
+func logSkippedVersions(logWriter io.Writer, skipped []skippedVersion) {
+	if len(skipped) == 0 {
+		return
+	}
+
+	fmt.Fprintf(logWriter, "Warning: skipped %d release(s) whose version could not be parsed:\n", len(skipped))
+	for _, release := range skipped {
+		fmt.Fprintf(logWriter, "  version=%q reason=%q\n", release.Version, release.Reason)
+	}
+}
+
+// UnicodeIndex lists the ICU releases of a download index, which is either a
+// JSON document in the releaseIndex format or an HTML page linking to the
+// release files or to one directory per release. Files are grouped into
+// releases by their directory, and the version is taken from the name of the
+// source tarball.
+type UnicodeIndex struct {
+	uri       string
+	client    *http.Client
+	logWriter io.Writer
+}
+
+func NewUnicodeIndex(uri string) UnicodeIndex {
+	return UnicodeIndex{
+		uri:       uri,
+		client:    http.DefaultClient,
+		logWriter: os.Stderr,
+	}
+}
+
+func (u UnicodeIndex) WithLogWriter(writer io.Writer) UnicodeIndex {
+	u.logWriter = writer
+	return u
+}
+
+func (u UnicodeIndex) GetIcuVersions() (versionology.VersionFetcherArray, error) {
+	location, err := url.Parse(u.uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse index URL %q: %w", u.uri, err)
+	}
+
+	content, contentType, err := u.get(location)
+	if err != nil {
+		return nil, err
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "application/json" {
+		var index releaseIndex
+		err = json.Unmarshal(content, &index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse index %s: %w", location.Redacted(), err)
+		}
+
+		return index.icuReleases(location, u.logWriter)
+	}
+
+	links, err := parseLinks(location, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// an index of release directories is followed one level down
+	var files []*url.URL
+	for _, link := range links {
+		if !strings.HasSuffix(link.Path, "/") {
+			files = append(files, link)
+			continue
+		}
+
+		if !strings.HasPrefix(link.Path, location.Path) || link.Path == location.Path || link.Host != location.Host {
+			continue
+		}
+
+		content, _, err := u.get(link)
+		if err != nil {
+			return nil, err
+		}
+
+		directoryLinks, err := parseLinks(link, content)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, directoryLinks...)
+	}
+
+	return groupReleaseFiles(files, u.logWriter), nil
+}
+
+func (u UnicodeIndex) get(location *url.URL) ([]byte, string, error) {
+	resp, err := u.client.Get(location.String())
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return nil, "", fmt.Errorf("received a non 200 status code from %s: status code %d received", location.Redacted(), resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return content, resp.Header.Get("Content-Type"), nil
+}
+
+func parseLinks(location *url.URL, content []byte) ([]*url.URL, error) {
+	var links []*url.URL
+	for _, match := range hrefPattern.FindAllSubmatch(content, -1) {
+		link, err := location.Parse(string(match[1]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse link %q of %s: %w", match[1], location.Redacted(), err)
+		}
+
+		link.Fragment = ""
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// groupReleaseFiles returns a release for every directory that holds an ICU
+// source tarball, with all the files of that directory. Directories whose
+// version cannot be parsed are skipped and listed in a warning.
+func groupReleaseFiles(links []*url.URL, logWriter io.Writer) versionology.VersionFetcherArray {
+	directories := map[string][]ReleaseFile{}
+	versions := map[string]string{}
+
+	for _, link := range links {
+		directory, name := path.Split(link.Path)
+		if name == "" {
+			continue
+		}
+
+		key := link.Scheme + "://" + link.Host + directory
+		directories[key] = append(directories[key], ReleaseFile{Name: name, URL: link.String()})
+
+		for _, pattern := range sourceFilePatterns {
+			if match := pattern.FindStringSubmatch(name); match != nil {
+				versions[key] = strings.ReplaceAll(match[1], "_", ".")
+			}
+		}
+	}
+
+	var keys []string
+	for key := range versions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var (
+		releases versionology.VersionFetcherArray
+		skipped  []skippedVersion
+	)
+	for _, key := range keys {
+		version, err := semver.NewVersion(versions[key])
+		if err != nil {
+			skipped = append(skipped, skippedVersion{Version: versions[key], Reason: err.Error()})
+			continue
+		}
+
+		releases = append(releases, IcuRelease{
+			SemVer:         version,
+			ReleaseVersion: versions[key],
+			Files:          directories[key],
+		})
+	}
+
+	logSkippedVersions(logWriter, skipped)
+
+	return releases
+}
+
+// MirrorManifest lists the ICU releases of a local JSON file in the
+// releaseIndex format, so that retrieval can run against a mirror without
+// access to GitHub. Relative file URLs are resolved against the base-url of
+// the manifest.
+type MirrorManifest struct {
+	path      string
+	logWriter io.Writer
+}
+
+func NewMirrorManifest(path string) MirrorManifest {
+	return MirrorManifest{
+		path:      path,
+		logWriter: os.Stderr,
+	}
+}
+
+func (m MirrorManifest) WithLogWriter(writer io.Writer) MirrorManifest {
+	m.logWriter = writer
+	return m
+}
+
+func (m MirrorManifest) GetIcuVersions() (versionology.VersionFetcherArray, error) {
+	content, err := os.ReadFile(m.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mirror manifest: %w", err)
+	}
+
+	var index releaseIndex
+	err = json.Unmarshal(content, &index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mirror manifest %s: %w", m.path, err)
+	}
+
+	if index.BaseURL == "" {
+		for _, release := range index.Releases {
+			for _, file := range release.Files {
+				uri, err := url.Parse(file.URL)
+				if err != nil || !uri.IsAbs() {
+					return nil, fmt.Errorf("failed to parse mirror manifest %s: the URL of %s must be absolute when no base-url is set", m.path, file.Name)
+				}
+			}
+		}
+	}
+
+	return index.icuReleases(&url.URL{}, m.logWriter)
+}
@@ -0,0 +1,392 @@
+package components_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
+	"github.com/paketo-buildpacks/libdependency/versionology"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testSources(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/repos/unicode-org/icu/releases":
+				w.Header().Set("Content-Type", "application/json")
+				if req.URL.Query().Get("page") != "1" {
+					fmt.Fprintln(w, `[]`)
+					return
+				}
+
+				fmt.Fprintln(w, `[
+  {
+    "tag_name": "release-72-1",
+    "name": "ICU 72.1",
+    "assets": [
+      {
+        "name": "icu4c-72_1-src.tgz",
+        "browser_download_url": "https://github.com/unicode-org/icu/releases/download/release-72-1/icu4c-72_1-src.tgz"
+      }
+    ]
+  }
+]`)
+
+			case "/icu/":
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				fmt.Fprintln(w, `<html>
+<body>
+  <a href="../">Parent Directory</a>
+  <a href="/icu/">ICU</a>
+  <a href="72.1/">72.1/</a>
+  <a HREF='/icu/78.1/'>78.1/</a>
+  <a href="docs/">docs/</a>
+  <a href="https://example.com/icu/73.1/">elsewhere</a>
+</body>
+</html>`)
+
+			case "/icu/72.1/":
+				w.Header().Set("Content-Type", "text/html")
+				fmt.Fprintln(w, `<a href="icu4c-72_1-src.tgz">icu4c-72_1-src.tgz</a>
+<a href="icu4c-72_1-src.tgz.asc#signature">icu4c-72_1-src.tgz.asc</a>`)
+
+			case "/icu/78.1/":
+				w.Header().Set("Content-Type", "text/html")
+				fmt.Fprintln(w, `<a href="icu4c-78.1-sources.tgz">icu4c-78.1-sources.tgz</a>
+<a href="https://mirror.example.com/icu/78.1/icu4c-78.1-sources.tgz.asc">icu4c-78.1-sources.tgz.asc</a>`)
+
+			case "/icu/docs/":
+				w.Header().Set("Content-Type", "text/html")
+				fmt.Fprintln(w, `<a href="userguide.html">User Guide</a>`)
+
+			case "/index.json":
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintln(w, `{
+  "releases": [
+    {
+      "version": "72.1",
+      "files": [
+        { "name": "icu4c-72_1-src.tgz", "url": "icu/72.1/icu4c-72_1-src.tgz" },
+        { "url": "https://mirror.example.com/icu/72.1/icu4c-72_1-src.tgz.asc" }
+      ]
+    }
+  ]
+}`)
+
+			case "/bad-version.json":
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintln(w, `{ "releases": [ { "version": "invalid" }, { "version": "72.1" } ] }`)
+
+			case "/no-parse.json":
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintln(w, `???`)
+
+			case "/non-200/":
+				w.WriteHeader(http.StatusTeapot)
+
+			default:
+				http.NotFound(w, req)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	context("GitHub", func() {
+		var source components.VersionSource
+
+		it.Before(func() {
+			source = components.NewFetcher().WithAPI(server.URL).WithToken("")
+		})
+
+		it("lists the releases of the repository", func() {
+			releases, err := source.GetIcuVersions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(releases).To(BeEquivalentTo([]versionology.VersionFetcher{
+				components.IcuRelease{
+					SemVer:         semver.MustParse("72.1"),
+					ReleaseVersion: "72.1",
+					Files: []components.ReleaseFile{
+						{
+							Name: "icu4c-72_1-src.tgz",
+							URL:  "https://github.com/unicode-org/icu/releases/download/release-72-1/icu4c-72_1-src.tgz",
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	context("UnicodeIndex", func() {
+		var source components.VersionSource
+
+		context("when the index is an HTML page", func() {
+			it.Before(func() {
+				source = components.NewUnicodeIndex(fmt.Sprintf("%s/icu/", server.URL))
+			})
+
+			it("lists a release for every directory with a source tarball", func() {
+				releases, err := source.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(releases).To(BeEquivalentTo([]versionology.VersionFetcher{
+					components.IcuRelease{
+						SemVer:         semver.MustParse("72.1"),
+						ReleaseVersion: "72.1",
+						Files: []components.ReleaseFile{
+							{
+								Name: "icu4c-72_1-src.tgz",
+								URL:  fmt.Sprintf("%s/icu/72.1/icu4c-72_1-src.tgz", server.URL),
+							},
+							{
+								Name: "icu4c-72_1-src.tgz.asc",
+								URL:  fmt.Sprintf("%s/icu/72.1/icu4c-72_1-src.tgz.asc", server.URL),
+							},
+						},
+					},
+					components.IcuRelease{
+						SemVer:         semver.MustParse("78.1"),
+						ReleaseVersion: "78.1",
+						Files: []components.ReleaseFile{
+							{
+								Name: "icu4c-78.1-sources.tgz",
+								URL:  fmt.Sprintf("%s/icu/78.1/icu4c-78.1-sources.tgz", server.URL),
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		context("when the index is a JSON document", func() {
+			it.Before(func() {
+				source = components.NewUnicodeIndex(fmt.Sprintf("%s/index.json", server.URL))
+			})
+
+			it("lists the releases of the document", func() {
+				releases, err := source.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(releases).To(BeEquivalentTo([]versionology.VersionFetcher{
+					components.IcuRelease{
+						SemVer:         semver.MustParse("72.1"),
+						ReleaseVersion: "72.1",
+						Files: []components.ReleaseFile{
+							{
+								Name: "icu4c-72_1-src.tgz",
+								URL:  fmt.Sprintf("%s/icu/72.1/icu4c-72_1-src.tgz", server.URL),
+							},
+							{
+								Name: "icu4c-72_1-src.tgz.asc",
+								URL:  "https://mirror.example.com/icu/72.1/icu4c-72_1-src.tgz.asc",
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		context("when a version of the JSON index is unparsable", func() {
+			var logs *bytes.Buffer
+
+			it.Before(func() {
+				logs = bytes.NewBuffer(nil)
+				source = components.NewUnicodeIndex(fmt.Sprintf("%s/bad-version.json", server.URL)).WithLogWriter(logs)
+			})
+
+			it("skips the release and lists it in a warning", func() {
+				releases, err := source.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(releases).To(BeEquivalentTo([]versionology.VersionFetcher{
+					components.IcuRelease{
+						SemVer:         semver.MustParse("72.1"),
+						ReleaseVersion: "72.1",
+					},
+				}))
+
+				Expect(logs.String()).To(ContainSubstring("Warning: skipped 1 release(s) whose version could not be parsed:\n"))
+				Expect(logs.String()).To(ContainSubstring(`  version="invalid" reason="invalid semantic version"`))
+			})
+		})
+
+		context("failure cases", func() {
+			context("when the index cannot be fetched", func() {
+				it.Before(func() {
+					source = components.NewUnicodeIndex("not a valid URL")
+				})
+
+				it("returns an error", func() {
+					_, err := source.GetIcuVersions()
+					Expect(err).To(MatchError(ContainSubstring("unsupported protocol scheme")))
+				})
+			})
+
+			context("when the index returns a non 200 code", func() {
+				it.Before(func() {
+					source = components.NewUnicodeIndex(fmt.Sprintf("%s/non-200/", server.URL))
+				})
+
+				it("returns an error", func() {
+					_, err := source.GetIcuVersions()
+					Expect(err).To(MatchError(fmt.Sprintf("received a non 200 status code from %s/non-200/: status code 418 received", server.URL)))
+				})
+			})
+
+			context("when the JSON index cannot be parsed", func() {
+				it.Before(func() {
+					source = components.NewUnicodeIndex(fmt.Sprintf("%s/no-parse.json", server.URL))
+				})
+
+				it("returns an error", func() {
+					_, err := source.GetIcuVersions()
+					Expect(err).To(MatchError(ContainSubstring("invalid character '?' looking for beginning of value")))
+				})
+			})
+		})
+	})
+
+	context("MirrorManifest", func() {
+		var (
+			source   components.VersionSource
+			manifest string
+		)
+
+		it.Before(func() {
+			manifest = filepath.Join(t.TempDir(), "manifest.json")
+			Expect(os.WriteFile(manifest, []byte(`{
+  "base-url": "https://mirror.example.com/icu/",
+  "releases": [
+    {
+      "version": "72.1",
+      "files": [
+        { "name": "icu4c-72_1-src.tgz", "url": "72.1/icu4c-72_1-src.tgz" }
+      ]
+    },
+    {
+      "version": "71.2",
+      "files": [
+        { "url": "https://other.example.com/icu4c-71_2-src.tgz" }
+      ]
+    }
+  ]
+}`), 0600)).To(Succeed())
+
+			source = components.NewMirrorManifest(manifest)
+		})
+
+		it("lists the releases of the manifest", func() {
+			releases, err := source.GetIcuVersions()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(releases).To(BeEquivalentTo([]versionology.VersionFetcher{
+				components.IcuRelease{
+					SemVer:         semver.MustParse("72.1"),
+					ReleaseVersion: "72.1",
+					Files: []components.ReleaseFile{
+						{
+							Name: "icu4c-72_1-src.tgz",
+							URL:  "https://mirror.example.com/icu/72.1/icu4c-72_1-src.tgz",
+						},
+					},
+				},
+				components.IcuRelease{
+					SemVer:         semver.MustParse("71.2"),
+					ReleaseVersion: "71.2",
+					Files: []components.ReleaseFile{
+						{
+							Name: "icu4c-71_2-src.tgz",
+							URL:  "https://other.example.com/icu4c-71_2-src.tgz",
+						},
+					},
+				},
+			}))
+		})
+
+		context("when a version of the manifest is unparsable", func() {
+			var logs *bytes.Buffer
+
+			it.Before(func() {
+				Expect(os.WriteFile(manifest, []byte(`{
+  "base-url": "https://mirror.example.com/icu/",
+  "releases": [
+    { "version": "release-72", "files": [ { "url": "72/icu4c-72-src.tgz" } ] },
+    { "version": "72.1", "files": [ { "url": "72.1/icu4c-72_1-src.tgz" } ] }
+  ]
+}`), 0600)).To(Succeed())
+
+				logs = bytes.NewBuffer(nil)
+				source = components.NewMirrorManifest(manifest).WithLogWriter(logs)
+			})
+
+			it("skips the release and lists it in a warning", func() {
+				releases, err := source.GetIcuVersions()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(releases).To(HaveLen(1))
+				Expect(releases[0].Version().String()).To(Equal("72.1.0"))
+
+				Expect(logs.String()).To(ContainSubstring("Warning: skipped 1 release(s) whose version could not be parsed:\n"))
+				Expect(logs.String()).To(ContainSubstring(`  version="release-72" reason="invalid semantic version"`))
+			})
+		})
+
+		context("failure cases", func() {
+			context("when the manifest cannot be read", func() {
+				it.Before(func() {
+					source = components.NewMirrorManifest(filepath.Join(t.TempDir(), "missing.json"))
+				})
+
+				it("returns an error", func() {
+					_, err := source.GetIcuVersions()
+					Expect(err).To(MatchError(ContainSubstring("failed to read mirror manifest")))
+					Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+				})
+			})
+
+			context("when the manifest cannot be parsed", func() {
+				it.Before(func() {
+					Expect(os.WriteFile(manifest, []byte(`???`), 0600)).To(Succeed())
+				})
+
+				it("returns an error", func() {
+					_, err := source.GetIcuVersions()
+					Expect(err).To(MatchError(ContainSubstring("invalid character '?' looking for beginning of value")))
+				})
+			})
+
+			context("when a file URL is relative and there is no base-url", func() {
+				it.Before(func() {
+					Expect(os.WriteFile(manifest, []byte(`{
+  "releases": [
+    { "version": "72.1", "files": [ { "name": "icu4c-72_1-src.tgz", "url": "72.1/icu4c-72_1-src.tgz" } ] }
+  ]
+}`), 0600)).To(Succeed())
+				})
+
+				it("returns an error", func() {
+					_, err := source.GetIcuVersions()
+					Expect(err).To(MatchError(ContainSubstring("the URL of icu4c-72_1-src.tgz must be absolute when no base-url is set")))
+				})
+			})
+		})
+	})
+}
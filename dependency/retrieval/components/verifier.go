@@ -2,25 +2,88 @@ package components
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"io"
-	"net/http"
-	"os"
 	"strings"
+	"time"
 
-	"github.com/keybase/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	openpgp "github.com/ProtonMail/go-crypto/openpgp/v2"
 )
 
+// verificationConfig keeps the algorithm requirements of the OpenPGP library,
+// which rejects MD5 and RIPEMD-160 signatures, DSA and ElGamal keys, and RSA
+// keys shorter than 2047 bits.
+var verificationConfig = &packet.Config{}
+
+// VerificationResult describes the signature that a release was verified
+// with, along with the keys of the keyring that could not be used. The method
+// is the verification policy method that produced it, and the issuer and
+// predicate type are only set for attestations. The verification time is when
+// the evidence was first verified, and is kept when a later run verifies the
+// evidence stored in the artifact cache again.
+type VerificationResult struct {
+	Method        string       `json:"method,omitempty"`
+	Fingerprint   string       `json:"fingerprint"`
+	UserID        string       `json:"user-id"`
+	CreationTime  time.Time    `json:"creation-time"`
+	HashAlgorithm string       `json:"hash-algorithm"`
+	Issuer        string       `json:"issuer,omitempty"`
+	PredicateType string       `json:"predicate-type,omitempty"`
+	KeyFailures   []KeyFailure `json:"key-failures,omitempty"`
+	VerifiedAt    time.Time    `json:"verified-at,omitzero"`
+}
+
+// KeyFailure is why a key was not used, or did not verify the signature. The
+// key is given by its fingerprint, or by the key ID that the signature names
+// when no key of the keyring has that ID, and is empty for a key block that
+// could not be read.
+type KeyFailure struct {
+	Key    string `json:"key,omitempty"`
+	Reason string `json:"reason"`
+}
+
+func (f KeyFailure) String() string {
+	if f.Key == "" {
+		return f.Reason
+	}
+
+	return fmt.Sprintf("%s: %s", f.Key, f.Reason)
+}
+
+// VerificationError is returned when no key of the keyring verifies the
+// signature, and lists why for every key.
+type VerificationError struct {
+	KeyFailures []KeyFailure
+}
+
+func (e VerificationError) Error() string {
+	if len(e.KeyFailures) == 0 {
+		return "no valid pgp keys provided"
+	}
+
+	var failures []string
+	for _, failure := range e.KeyFailures {
+		failures = append(failures, failure.String())
+	}
+
+	return fmt.Sprintf("no valid pgp keys provided: %s", strings.Join(failures, "; "))
+}
+
+// Verifier checks detached signatures against a keyring in the format of the
+// ICU KEYS file. RSA, ECDSA and EdDSA keys, including Ed25519 keys, are
+// supported in the v4 and v6 packet formats. When fingerprints are pinned,
+// only the keys with those fingerprints are used; revoked, expired and
+// non-signing keys are never used.
 type Verifier struct {
-	publicKeyBlock string
-	errorWriter    io.Writer
+	publicKeyBlock     string
+	pinnedFingerprints []string
 }
 
 func NewVerifier() Verifier {
 	return Verifier{
 		publicKeyBlock: icuPublicKeyBlock,
-		errorWriter:    os.Stderr,
 	}
 }
 
@@ -29,76 +92,94 @@ func (v Verifier) WithPublicKeyBlock(key string) Verifier {
 	return v
 }
 
-func (v Verifier) WithErrorWriter(writer io.Writer) Verifier {
-	v.errorWriter = writer
+// WithPinnedFingerprints restricts the keyring to the keys with the given
+// fingerprints, which are compared case-insensitively and without spaces.
+func (v Verifier) WithPinnedFingerprints(fingerprints ...string) Verifier {
+	v.pinnedFingerprints = fingerprints
 	return v
 }
 
-func (v Verifier) Verify(signatureURL, targetURL string) error {
-	targetResponse, err := http.Get(targetURL)
+// Verify checks the detached armored signature of the target against the
+// usable keys of the keyring, and returns the key that made it. Keys that were
+// not used are listed in the result, or in a VerificationError when no key
+// verifies the signature.
+func (v Verifier) Verify(signature io.Reader, target io.ReadSeeker) (VerificationResult, error) {
+	signatureBytes, err := io.ReadAll(signature)
 	if err != nil {
-		return err
+		return VerificationResult{}, err
 	}
-	defer targetResponse.Body.Close()
 
-	if !(targetResponse.StatusCode >= 200 && targetResponse.StatusCode < 300) {
-		return fmt.Errorf("received a non 200 status code from %s: status code %d received", targetURL, targetResponse.StatusCode)
+	keyring, failures := readKeyring(v.publicKeyBlock, v.pinnedFingerprints, time.Now())
+	if len(keyring) == 0 {
+		return VerificationResult{}, VerificationError{KeyFailures: failures}
 	}
 
-	signatureResponse, err := http.Get(signatureURL)
+	issuer, err := readIssuer(signatureBytes)
 	if err != nil {
-		return err
+		return VerificationResult{}, err
 	}
-	defer signatureResponse.Body.Close()
 
-	if !(signatureResponse.StatusCode >= 200 && signatureResponse.StatusCode < 300) {
-		return fmt.Errorf("received a non 200 status code from %s: status code %d received", signatureURL, signatureResponse.StatusCode)
+	_, err = target.Seek(0, io.SeekStart)
+	if err != nil {
+		return VerificationResult{}, err
 	}
 
-	// Need to get all of the bytes to allow for the file to be read multiple times
-	signatureBytes, err := io.ReadAll(signatureResponse.Body)
+	sig, signer, err := openpgp.VerifyArmoredDetachedSignature(keyring, target, bytes.NewReader(signatureBytes), verificationConfig)
 	if err != nil {
-		return err
+		return VerificationResult{}, VerificationError{KeyFailures: append(failures, KeyFailure{Key: issuer.key(keyring), Reason: err.Error()})}
+	}
+
+	return VerificationResult{
+		Fingerprint:   Fingerprint(signer.PrimaryKey.Fingerprint),
+		UserID:        userID(signer, sig.CreationTime),
+		CreationTime:  sig.CreationTime.UTC(),
+		HashAlgorithm: sig.Hash.String(),
+		KeyFailures:   failures,
+	}, nil
+}
+
+type signatureIssuer struct {
+	keyID       uint64
+	fingerprint []byte
+}
+
+// key returns the fingerprint of the key of the keyring that made the
+// signature, or the fingerprint or key ID that the signature names when the
+// keyring has no such key.
+func (i signatureIssuer) key(keyring openpgp.EntityList) string {
+	for _, entity := range keyring.EntitiesById(i.keyID) {
+		return Fingerprint(entity.PrimaryKey.Fingerprint)
 	}
 
-	keys := parseKeys(v.publicKeyBlock)
-	for _, key := range keys {
-		keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
-		if err != nil {
-			fmt.Fprintf(v.errorWriter, "failed to read armored key: %s\n", err.Error())
-			continue
-		}
-
-		_, err = openpgp.CheckArmoredDetachedSignature(keyring, targetResponse.Body, bytes.NewReader(signatureBytes))
-		if err != nil {
-			fmt.Fprintf(v.errorWriter, "failed to check signature: %s\n", err.Error())
-			continue
-		}
-
-		// Valid and matching PGP was found
-		return nil
+	if len(i.fingerprint) > 0 {
+		return Fingerprint(i.fingerprint)
 	}
 
-	return errors.New("no valid pgp keys provided")
+	return fmt.Sprintf("%016X", i.keyID)
 }
 
-func parseKeys(block string) []string {
-	var keys []string
-	var currentKey string
-	inKey := false
-
-	for _, line := range strings.Split(string(block), "\n") {
-		if line == "-----BEGIN PGP PUBLIC KEY BLOCK-----" {
-			currentKey = line + "\n"
-			inKey = true
-		} else if line == "-----END PGP PUBLIC KEY BLOCK-----" {
-			currentKey = currentKey + line
-			keys = append(keys, currentKey)
-			inKey = false
-		} else if inKey {
-			currentKey = currentKey + line + "\n"
-		}
+// readIssuer returns the key that the first signature packet of an armored
+// detached signature names as its issuer.
+func readIssuer(signature []byte) (signatureIssuer, error) {
+	block, err := armor.Decode(bytes.NewReader(signature))
+	if err != nil {
+		return signatureIssuer{}, fmt.Errorf("failed to read signature: %w", err)
+	}
+
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return signatureIssuer{}, fmt.Errorf("failed to read signature: %w", err)
+	}
+
+	sig, ok := p.(*packet.Signature)
+	if !ok {
+		return signatureIssuer{}, fmt.Errorf("failed to read signature: found a %T packet instead of a signature", p)
+	}
+
+	issuer := signatureIssuer{fingerprint: sig.IssuerFingerprint}
+	if sig.IssuerKeyId != nil {
+		issuer.keyID = *sig.IssuerKeyId
 	}
 
-	return keys
+	return issuer, nil
 }
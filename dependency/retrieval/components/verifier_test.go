@@ -4,13 +4,16 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"errors"
 	"fmt"
-	"net/http"
-	"net/http/httptest"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
-	"github.com/keybase/go-crypto/openpgp"
-	"github.com/keybase/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	openpgp "github.com/ProtonMail/go-crypto/openpgp/v2"
 	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
 	"github.com/sclevine/spec"
 
@@ -22,8 +25,46 @@ func testVerifier(t *testing.T, context spec.G, it spec.S) {
 	var (
 		Expect = NewWithT(t).Expect
 
-		server   *httptest.Server
-		verifier components.Verifier
+		verifier    components.Verifier
+		source      []byte
+		signature   []byte
+		fingerprint string
+		publicKey   []byte
+
+		newKey = func(config *packet.Config) *openpgp.Entity {
+			entity, err := openpgp.NewEntity("ICU Release Robot", "", "robot@example.com", config)
+			Expect(err).NotTo(HaveOccurred())
+
+			return entity
+		}
+
+		serialize = func(entities ...*openpgp.Entity) []byte {
+			buffer := bytes.NewBuffer(nil)
+			for _, entity := range entities {
+				Expect(entity.Serialize(buffer)).To(Succeed())
+			}
+
+			return buffer.Bytes()
+		}
+
+		armorKeys = func(keys []byte, headers map[string]string) string {
+			buffer := bytes.NewBuffer(nil)
+			armoredKeyWriter, err := armor.Encode(buffer, "PGP PUBLIC KEY BLOCK", headers)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = armoredKeyWriter.Write(keys)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(armoredKeyWriter.Close()).To(Succeed())
+
+			return buffer.String()
+		}
+
+		sign = func(entity *openpgp.Entity, content []byte) []byte {
+			buffer := bytes.NewBuffer(nil)
+			Expect(openpgp.ArmoredDetachSign(buffer, []*openpgp.Entity{entity}, bytes.NewReader(content), nil)).To(Succeed())
+
+			return buffer.Bytes()
+		}
 	)
 
 	it.Before(func() {
@@ -39,160 +80,176 @@ func testVerifier(t *testing.T, context spec.G, it spec.S) {
 		Expect(tw.Close()).To(Succeed())
 		Expect(gw.Close()).To(Succeed())
 
-		entity, err := openpgp.NewEntity("", "", "", nil)
-		Expect(err).NotTo(HaveOccurred())
+		source = buffer.Bytes()
 
-		// A message reader needs to be created to prevent the cursor from moving
-		// in the tar.gz buffer
-		message := bytes.NewReader(buffer.Bytes())
-		ascBuffer := bytes.NewBuffer(nil)
-		err = openpgp.ArmoredDetachSign(ascBuffer, entity, message, nil)
-		Expect(err).NotTo(HaveOccurred())
+		entity := newKey(nil)
+		signature = sign(entity, source)
+		publicKey = serialize(entity)
+		fingerprint = components.Fingerprint(entity.PrimaryKey.Fingerprint)
 
-		primaryKey := bytes.NewBuffer(nil)
+		verifier = components.NewVerifier().WithPublicKeyBlock(`-----BEGIN PGP PUBLIC KEY BLOCK-----
+another key
+-----END PGP PUBLIC KEY BLOCK-----
+` + armorKeys(publicKey, nil))
+	})
 
-		// This needs needs to be called as a work around. It appears to sign all
-		// signatures for the entity. https://stackoverflow.com/a/33513828
-		err = entity.SerializePrivate(bytes.NewBuffer(nil), nil)
-		Expect(err).NotTo(HaveOccurred())
+	context("Verify", func() {
+		it("verifies the target with the asc file and returns the signer", func() {
+			result, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
 
-		err = entity.Serialize(primaryKey)
-		Expect(err).NotTo(HaveOccurred())
+			Expect(result.Fingerprint).To(Equal(fingerprint))
+			Expect(result.UserID).To(Equal("ICU Release Robot <robot@example.com>"))
+			Expect(result.HashAlgorithm).To(Equal("SHA-256"))
+			Expect(result.CreationTime).NotTo(BeZero())
+			Expect(result.CreationTime.Location()).To(Equal(time.UTC))
+			Expect(result.KeyFailures).To(Equal([]components.KeyFailure{
+				{Reason: "failed to read armored key: openpgp: invalid argument: no armored data found"},
+			}))
+		})
 
-		armoredPubKey := bytes.NewBuffer(nil)
+		context("with each supported key type", func() {
+			for _, example := range []struct {
+				name   string
+				config *packet.Config
+			}{
+				{name: "RSA v4", config: &packet.Config{Algorithm: packet.PubKeyAlgoRSA, RSABits: 2048}},
+				{name: "ECDSA P-256 v4", config: &packet.Config{Algorithm: packet.PubKeyAlgoECDSA, Curve: packet.CurveNistP256}},
+				{name: "EdDSA Curve25519 v4", config: &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA, Curve: packet.Curve25519}},
+				{name: "Ed25519 v4", config: &packet.Config{Algorithm: packet.PubKeyAlgoEd25519}},
+				{name: "Ed25519 v6", config: &packet.Config{Algorithm: packet.PubKeyAlgoEd25519, V6Keys: true}},
+				{name: "ECDSA P-384 v6", config: &packet.Config{Algorithm: packet.PubKeyAlgoECDSA, Curve: packet.CurveNistP384, V6Keys: true}},
+				{name: "RSA v6", config: &packet.Config{Algorithm: packet.PubKeyAlgoRSA, RSABits: 3072, V6Keys: true}},
+			} {
+				example := example
+
+				it(fmt.Sprintf("verifies a signature made with an %s key", example.name), func() {
+					entity := newKey(example.config)
+
+					result, err := verifier.
+						WithPublicKeyBlock(armorKeys(serialize(entity), nil)).
+						Verify(bytes.NewReader(sign(entity, source)), bytes.NewReader(source))
+					Expect(err).NotTo(HaveOccurred())
 
-		armoredKeyWriter, err := armor.Encode(armoredPubKey, "PGP PUBLIC KEY BLOCK", nil)
-		Expect(err).NotTo(HaveOccurred())
+					Expect(result.Fingerprint).To(Equal(components.Fingerprint(entity.PrimaryKey.Fingerprint)))
+					Expect(result.UserID).To(Equal("ICU Release Robot <robot@example.com>"))
+					Expect(result.KeyFailures).To(BeEmpty())
+				})
+			}
 
-		_, err = armoredKeyWriter.Write(primaryKey.Bytes())
-		Expect(err).NotTo(HaveOccurred())
+			it("verifies a signature made with a v6 key without a user ID", func() {
+				entity, err := openpgp.NewEntityWithoutId(&packet.Config{Algorithm: packet.PubKeyAlgoEd25519, V6Keys: true})
+				Expect(err).NotTo(HaveOccurred())
 
-		armoredKeyWriter.Close()
+				result, err := verifier.
+					WithPublicKeyBlock(armorKeys(serialize(entity), nil)).
+					Verify(bytes.NewReader(sign(entity, source)), bytes.NewReader(source))
+				Expect(err).NotTo(HaveOccurred())
 
-		verifier = components.NewVerifier().WithPublicKeyBlock(`-----BEGIN PGP PUBLIC KEY BLOCK-----
-another key
------END PGP PUBLIC KEY BLOCK-----
-` + armoredPubKey.String())
+				Expect(result.Fingerprint).To(HaveLen(64))
+				Expect(result.Fingerprint).To(Equal(components.Fingerprint(entity.PrimaryKey.Fingerprint)))
+				Expect(result.UserID).To(BeEmpty())
+			})
 
-		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-			if req.Method == http.MethodHead {
-				http.Error(w, "NotFound", http.StatusNotFound)
-				return
-			}
+			it("verifies a signature made with a signing subkey", func() {
+				entity := newKey(&packet.Config{Algorithm: packet.PubKeyAlgoEd25519})
+				Expect(entity.AddSigningSubkey(&packet.Config{Algorithm: packet.PubKeyAlgoEd25519})).To(Succeed())
 
-			switch req.URL.Path {
-			case "/source":
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write(buffer.Bytes())
+				result, err := verifier.
+					WithPublicKeyBlock(armorKeys(serialize(entity), nil)).
+					Verify(bytes.NewReader(sign(entity, source)), bytes.NewReader(source))
 				Expect(err).NotTo(HaveOccurred())
 
-			case "/source-asc":
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write(ascBuffer.Bytes())
+				Expect(result.Fingerprint).To(Equal(components.Fingerprint(entity.PrimaryKey.Fingerprint)))
+			})
+		})
+
+		context("when the fingerprint of the key is pinned", func() {
+			it.Before(func() {
+				// fingerprints are compared case-insensitively and without spaces
+				verifier = verifier.WithPinnedFingerprints("0000000000000000000000000000000000000000", strings.ToLower(fmt.Sprintf("%s %s", fingerprint[:20], fingerprint[20:])))
+			})
+
+			it("verifies the target with the asc file", func() {
+				result, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
 				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Fingerprint).To(Equal(fingerprint))
+			})
+		})
 
-			case "/non-200":
-				w.WriteHeader(http.StatusTeapot)
+		context("when the keyring has CRLF line endings, comments and several keys per block", func() {
+			it.Before(func() {
+				keys := append(serialize(newKey(&packet.Config{Algorithm: packet.PubKeyAlgoEd25519})), publicKey...)
 
-			default:
-				t.Fatalf("unknown path: %s", req.URL.Path)
-			}
-		}))
-	})
+				keyring := "# the keys of the release managers\n" +
+					"pub   2048R/825EA2CD 2010-11-02\n" +
+					armorKeys(keys, map[string]string{"Comment": "two keys"}) + "  \n"
+				verifier = verifier.WithPublicKeyBlock(strings.ReplaceAll(keyring, "\n", "\r\n"))
+			})
 
-	context("Verify", func() {
-		it("verifies the target with the asc file", func() {
-			err := verifier.Verify(fmt.Sprintf("%s/source-asc", server.URL), fmt.Sprintf("%s/source", server.URL))
-			Expect(err).NotTo(HaveOccurred())
+			it("verifies the target with the asc file", func() {
+				result, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Fingerprint).To(Equal(fingerprint))
+				Expect(result.KeyFailures).To(BeEmpty())
+			})
 		})
 
 		context("failure cases", func() {
-			context("when the target get failed", func() {
-				it("returns an error", func() {
-					err := verifier.Verify(fmt.Sprintf("%s/source-asc", server.URL), "not a valid url")
-					Expect(err).To(MatchError(ContainSubstring("unsupported protocol scheme")))
+			context("when the fingerprint of the key is not pinned", func() {
+				it.Before(func() {
+					verifier = verifier.WithPinnedFingerprints("0000000000000000000000000000000000000000")
 				})
-			})
 
-			context("when the target get is a non 200 status code", func() {
 				it("returns an error", func() {
-					err := verifier.Verify(fmt.Sprintf("%s/source-asc", server.URL), fmt.Sprintf("%s/non-200", server.URL))
-					Expect(err).To(MatchError(fmt.Sprintf("received a non 200 status code from %s: status code 418 received", fmt.Sprintf("%s/non-200", server.URL))))
-				})
-			})
+					_, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
+					Expect(err).To(MatchError(fmt.Sprintf("no valid pgp keys provided: failed to read armored key: openpgp: invalid argument: no armored data found; %s: the fingerprint is not pinned", fingerprint)))
 
-			context("when the signature get failed", func() {
-				it("returns an error", func() {
-					err := verifier.Verify("not a valid url", fmt.Sprintf("%s/source", server.URL))
-					Expect(err).To(MatchError(ContainSubstring("unsupported protocol scheme")))
+					var verificationErr components.VerificationError
+					Expect(errors.As(err, &verificationErr)).To(BeTrue())
+					Expect(verificationErr.KeyFailures).To(ContainElement(components.KeyFailure{Key: fingerprint, Reason: "the fingerprint is not pinned"}))
 				})
 			})
 
-			context("when the signature get is a non 200 status code", func() {
+			context("when the signature cannot be read", func() {
 				it("returns an error", func() {
-					err := verifier.Verify(fmt.Sprintf("%s/non-200", server.URL), fmt.Sprintf("%s/source", server.URL))
-					Expect(err).To(MatchError(fmt.Sprintf("received a non 200 status code from %s: status code 418 received", fmt.Sprintf("%s/non-200", server.URL))))
+					_, err := verifier.Verify(iotest.ErrReader(errors.New("failed to read")), bytes.NewReader(source))
+					Expect(err).To(MatchError("failed to read"))
 				})
 			})
 
 			context("when public key is not armored", func() {
-				var errorBuffer *bytes.Buffer
-
 				it.Before(func() {
-					errorBuffer = bytes.NewBuffer(nil)
-
-					verifier = verifier.
-						WithPublicKeyBlock(`-----BEGIN PGP PUBLIC KEY BLOCK-----
+					verifier = verifier.WithPublicKeyBlock(`-----BEGIN PGP PUBLIC KEY BLOCK-----
 not a key
------END PGP PUBLIC KEY BLOCK-----`).
-						WithErrorWriter(errorBuffer)
+-----END PGP PUBLIC KEY BLOCK-----`)
 				})
 
 				it("returns an error", func() {
-					err := verifier.Verify(fmt.Sprintf("%s/source-asc", server.URL), fmt.Sprintf("%s/source", server.URL))
-					Expect(err).To(MatchError("no valid pgp keys provided"))
-
-					Expect(errorBuffer.String()).To(ContainSubstring("failed to read armored key: openpgp: invalid argument: no armored data found"))
+					_, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
+					Expect(err).To(MatchError("no valid pgp keys provided: failed to read armored key: openpgp: invalid argument: no armored data found"))
 				})
 			})
 
-			context("when the detached signature does not match the key", func() {
-				var errorBuffer *bytes.Buffer
-
+			context("when the keyring is empty", func() {
 				it.Before(func() {
-					entity, err := openpgp.NewEntity("", "", "", nil)
-					Expect(err).NotTo(HaveOccurred())
-
-					primaryKey := bytes.NewBuffer(nil)
-
-					// This needs needs to be called as a work around. It appears to sign all
-					// signatures for the entity. https://stackoverflow.com/a/33513828
-					err = entity.SerializePrivate(bytes.NewBuffer(nil), nil)
-					Expect(err).NotTo(HaveOccurred())
-
-					err = entity.Serialize(primaryKey)
-					Expect(err).NotTo(HaveOccurred())
-
-					armoredPubKey := bytes.NewBuffer(nil)
-
-					armoredKeyWriter, err := armor.Encode(armoredPubKey, "PGP PUBLIC KEY BLOCK", nil)
-					Expect(err).NotTo(HaveOccurred())
-
-					_, err = armoredKeyWriter.Write(primaryKey.Bytes())
-					Expect(err).NotTo(HaveOccurred())
-
-					armoredKeyWriter.Close()
-
-					errorBuffer = bytes.NewBuffer(nil)
-
-					verifier = verifier.WithPublicKeyBlock(armoredPubKey.String()).WithErrorWriter(errorBuffer)
+					verifier = verifier.WithPublicKeyBlock("")
 				})
 
 				it("returns an error", func() {
-					err := verifier.Verify(fmt.Sprintf("%s/source-asc", server.URL), fmt.Sprintf("%s/source", server.URL))
+					_, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
 					Expect(err).To(MatchError("no valid pgp keys provided"))
+				})
+			})
+
+			context("when the detached signature does not match the key", func() {
+				it.Before(func() {
+					verifier = verifier.WithPublicKeyBlock(armorKeys(serialize(newKey(&packet.Config{Algorithm: packet.PubKeyAlgoEd25519})), nil))
+				})
 
-					Expect(errorBuffer.String()).To(ContainSubstring("failed to check signature: openpgp: signature made by unknown entity"))
+				it("returns an error that names the key of the signature", func() {
+					_, err := verifier.Verify(bytes.NewReader(signature), bytes.NewReader(source))
+					Expect(err).To(MatchError(fmt.Sprintf("no valid pgp keys provided: %s: openpgp: signature made by unknown entity", fingerprint)))
 				})
 			})
 		})
@@ -4,7 +4,7 @@ go 1.26.6
 
 require (
 	github.com/Masterminds/semver/v3 v3.5.0
-	github.com/keybase/go-crypto v0.0.0-20200123153347-de78d2cb44f4
+	github.com/ProtonMail/go-crypto v1.4.1
 	github.com/onsi/gomega v1.42.1
 	github.com/paketo-buildpacks/libdependency v0.2.1
 	github.com/paketo-buildpacks/packit/v2 v2.25.7
@@ -15,7 +15,6 @@ require (
 	dario.cat/mergo v1.0.2 // indirect
 	github.com/BurntSushi/toml v1.6.0 // indirect
 	github.com/Microsoft/go-winio v0.6.3-0.20251027160822-ad3df93bed29 // indirect
-	github.com/ProtonMail/go-crypto v1.4.1 // indirect
 	github.com/anchore/packageurl-go v0.2.0 // indirect
 	github.com/cloudflare/circl v1.6.5 // indirect
 	github.com/cyphar/filepath-securejoin v0.7.0 // indirect
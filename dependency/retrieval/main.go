@@ -1,12 +1,124 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
 	"github.com/paketo-buildpacks/icu/dependency/retrieval/components"
 	"github.com/paketo-buildpacks/libdependency/retrieve"
 )
 
 func main() {
-	fetcher := components.NewFetcher()
-	generator := components.NewGenerator()
-	retrieve.NewMetadata("icu", fetcher.GetIcuVersions, generator.GenerateMetadata)
+	var format, source, repository, githubURL, indexURL, mirrorManifest, cacheDir, keyring, pinnedFingerprints string
+	var policy, trustRoot, attestationIdentity, attestationIssuer, provenanceDir string
+	flag.StringVar(&format, "format", components.FormatGzip, "compression of the compiled artifacts, one of gzip or zstd")
+	flag.StringVar(&cacheDir, "cache-dir", components.DefaultCacheDir(), "directory that release artifacts are downloaded to and verified from, reused across runs")
+	flag.StringVar(&keyring, "keyring", "", "file, directory or URL of a keyring in the format of the ICU KEYS file to verify signatures with, instead of the bundled one")
+	flag.StringVar(&pinnedFingerprints, "pinned-fingerprints", "", "comma-separated fingerprints of the keys that signatures may be made with, required for a keyring URL")
+	flag.StringVar(&policy, "policy", components.PolicyPGP, "how release sources are verified, one of pgp, attestation or either")
+	flag.StringVar(&trustRoot, "trust-root", "", "path of the Sigstore trusted_root.json to verify provenance attestations with, required for the attestation and either policies")
+	flag.StringVar(&attestationIdentity, "attestation-identity", "", "certificate identity, such as a workflow URI, that attestations must be signed by, required for the attestation and either policies")
+	flag.StringVar(&attestationIssuer, "attestation-issuer", "", "OIDC issuer that the certificate identity of attestations must come from, required for the attestation and either policies")
+	flag.StringVar(&provenanceDir, "provenance-dir", "", "directory to write the provenance statement of every version to, instead of the directory of the output")
+	flag.StringVar(&source, "source", "github", "where to list the ICU releases from, one of github, unicode or mirror")
+	flag.StringVar(&repository, "repository", "unicode-org/icu", "owner/name of the GitHub repository to list the ICU releases of")
+	flag.StringVar(&githubURL, "github-url", "", "base URL of a GitHub Enterprise Server to list the releases from, instead of github.com")
+	flag.StringVar(&indexURL, "index-url", "", "URL of the download index to list the releases from, for the unicode source")
+	flag.StringVar(&mirrorManifest, "mirror-manifest", "", "path of the JSON manifest to list the releases from, for the mirror source")
+
+	buildpackTomlPath, output := retrieve.FetchArgs()
+	if output == "" {
+		panic("output is required")
+	}
+
+	var versionSource components.VersionSource
+	switch source {
+	case "github":
+		fetcher := components.NewFetcher().WithRepository(repository)
+		if githubURL != "" {
+			fetcher = fetcher.WithEnterpriseURL(githubURL)
+		}
+		versionSource = fetcher
+
+	case "unicode":
+		if indexURL == "" {
+			panic("index-url is required for the unicode source")
+		}
+		versionSource = components.NewUnicodeIndex(indexURL)
+
+	case "mirror":
+		if mirrorManifest == "" {
+			panic("mirror-manifest is required for the mirror source")
+		}
+		versionSource = components.NewMirrorManifest(mirrorManifest)
+
+	default:
+		panic(fmt.Sprintf("unknown source %q: must be one of github, unicode or mirror", source))
+	}
+
+	generator := components.NewGenerator().WithFormat(format).WithCacheDir(cacheDir)
+
+	verifier := components.NewVerifier()
+	if keyring != "" {
+		if strings.HasPrefix(keyring, "http://") || strings.HasPrefix(keyring, "https://") {
+			if pinnedFingerprints == "" {
+				panic("pinned-fingerprints is required for a keyring URL")
+			}
+		}
+
+		publicKeyBlock, err := components.LoadKeyring(keyring)
+		if err != nil {
+			panic(err)
+		}
+		verifier = verifier.WithPublicKeyBlock(publicKeyBlock)
+	}
+	if pinnedFingerprints != "" {
+		verifier = verifier.WithPinnedFingerprints(strings.Split(pinnedFingerprints, ",")...)
+	}
+	generator = generator.WithVerifier(verifier).WithPolicy(policy)
+
+	if policy == components.PolicyAttestation || policy == components.PolicyEither {
+		if trustRoot == "" {
+			panic(fmt.Sprintf("trust-root is required for the %s policy", policy))
+		}
+		if attestationIdentity == "" || attestationIssuer == "" {
+			panic(fmt.Sprintf("attestation-identity and attestation-issuer are required for the %s policy", policy))
+		}
+	}
+
+	if trustRoot != "" {
+		root, err := components.LoadTrustRoot(trustRoot)
+		if err != nil {
+			panic(err)
+		}
+		generator = generator.WithAttestationVerifier(components.NewAttestationVerifier(root).WithIdentity(attestationIdentity, attestationIssuer))
+	}
+
+	// retrieve.NewMetadata reads the arguments again, so it is handed the ones
+	// parsed above along with the flags of this retrieval
+	retrieve.FetchArgs = func() (string, string) {
+		return buildpackTomlPath, output
+	}
+
+	retrieve.NewMetadata("icu", versionSource.GetIcuVersions, generator.GenerateMetadata)
+
+	err := generator.DecorateMetadata(output)
+	if err != nil {
+		panic(err)
+	}
+
+	if provenanceDir == "" {
+		provenanceDir = filepath.Dir(output)
+	}
+
+	paths, err := generator.WriteProvenance(provenanceDir)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, path := range paths {
+		fmt.Printf("Wrote provenance to %s\n", path)
+	}
 }
@@ -0,0 +1,279 @@
+package icu
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/fs"
+)
+
+// cacheSize returns the number of extracted ICU trees kept in the icu-cache
+// layer, as set by BP_ICU_CACHE_SIZE. The cache is disabled unless it is set
+// to a size greater than 0, so that it adds no layer by default.
+func cacheSize() (int, error) {
+	value, ok := os.LookupEnv("BP_ICU_CACHE_SIZE")
+	if !ok || value == "" {
+		return 0, nil
+	}
+
+	size, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse BP_ICU_CACHE_SIZE value %q: %w", value, err)
+	}
+
+	if size < 0 {
+		return 0, fmt.Errorf("failed to parse BP_ICU_CACHE_SIZE value %q: must not be negative", value)
+	}
+
+	return size, nil
+}
+
+// dependencyCache keeps the most recently used extracted ICU trees in a
+// cache-only layer, keyed by dependency checksum, so that switching back to a
+// previously installed version does not download it again.
+type dependencyCache struct {
+	layer packit.Layer
+	size  int
+	now   func() time.Time
+}
+
+type cacheEntry struct {
+	Checksum string
+	Version  string
+	LastUsed string
+}
+
+// openDependencyCache returns the cache for this build. When the cache is
+// disabled, any cache layer from a previous build is removed.
+func openDependencyCache(layers packit.Layers, size int, now func() time.Time) (dependencyCache, error) {
+	if size == 0 {
+		return dependencyCache{}, removeLayer(layers, ICUCacheLayerName)
+	}
+
+	layer, err := layers.Get(ICUCacheLayerName)
+	if err != nil {
+		return dependencyCache{}, err
+	}
+
+	layer.Launch, layer.Build, layer.Cache = false, false, true
+
+	return dependencyCache{
+		layer: layer,
+		size:  size,
+		now:   now,
+	}, nil
+}
+
+func (c dependencyCache) Enabled() bool {
+	return c.size > 0
+}
+
+func (c dependencyCache) entries() map[string]cacheEntry {
+	entries := map[string]cacheEntry{}
+
+	raw, _ := c.layer.Metadata["entries"].(map[string]interface{})
+	for key, value := range raw {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		checksum, _ := fields["checksum"].(string)
+		version, _ := fields["version"].(string)
+		lastUsed, _ := fields["last-used"].(string)
+
+		entries[key] = cacheEntry{
+			Checksum: checksum,
+			Version:  version,
+			LastUsed: lastUsed,
+		}
+	}
+
+	return entries
+}
+
+func (c *dependencyCache) setEntries(entries map[string]cacheEntry) {
+	raw := map[string]interface{}{}
+	for key, entry := range entries {
+		raw[key] = map[string]interface{}{
+			"checksum":  entry.Checksum,
+			"version":   entry.Version,
+			"last-used": entry.LastUsed,
+		}
+	}
+
+	c.layer.Metadata = map[string]interface{}{
+		"entries": raw,
+	}
+}
+
+// cacheKey turns a checksum such as "sha256:abc" into a name that is safe to
+// use as a directory and TOML key.
+func cacheKey(checksum string) string {
+	return strings.ReplaceAll(checksum, ":", "-")
+}
+
+// Restore copies the cached tree for the checksum into the destination and
+// reports whether there was one.
+func (c *dependencyCache) Restore(checksum, destination string) (bool, error) {
+	if !c.Enabled() {
+		return false, nil
+	}
+
+	key := cacheKey(checksum)
+	entries := c.entries()
+
+	entry, ok := entries[key]
+	if !ok {
+		return false, nil
+	}
+
+	source := filepath.Join(c.layer.Path, key)
+	if _, err := os.Stat(source); err != nil {
+		// the index refers to a tree that is gone, so it is dropped and the
+		// dependency is delivered as usual
+		delete(entries, key)
+		c.setEntries(entries)
+		return false, nil
+	}
+
+	err := fs.Copy(source, destination)
+	if err != nil {
+		return false, fmt.Errorf("failed to restore ICU from cache: %w", err)
+	}
+
+	entry.LastUsed = c.now().UTC().Format(time.RFC3339Nano)
+	entries[key] = entry
+	c.setEntries(entries)
+
+	return true, nil
+}
+
+// Store copies a freshly delivered tree into the cache and evicts the least
+// recently used trees beyond the cache size.
+func (c *dependencyCache) Store(checksum, version, source string) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	err := os.MkdirAll(c.layer.Path, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("failed to cache ICU: %w", err)
+	}
+
+	key := cacheKey(checksum)
+	err = fs.Copy(source, filepath.Join(c.layer.Path, key))
+	if err != nil {
+		return fmt.Errorf("failed to cache ICU: %w", err)
+	}
+
+	manifest, err := generateManifest(filepath.Join(c.layer.Path, key))
+	if err != nil {
+		return fmt.Errorf("failed to cache ICU: %w", err)
+	}
+
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to cache ICU: %w", err)
+	}
+
+	err = os.WriteFile(filepath.Join(c.layer.Path, fmt.Sprintf("%s.manifest.json", key)), content, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to cache ICU: %w", err)
+	}
+
+	entries := c.entries()
+	entries[key] = cacheEntry{
+		Checksum: checksum,
+		Version:  version,
+		LastUsed: c.now().UTC().Format(time.RFC3339Nano),
+	}
+
+	var keys []string
+	for key := range entries {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if entries[keys[i]].LastUsed == entries[keys[j]].LastUsed {
+			return keys[i] < keys[j]
+		}
+
+		return entries[keys[i]].LastUsed > entries[keys[j]].LastUsed
+	})
+
+	for len(keys) > c.size {
+		evicted := keys[len(keys)-1]
+		keys = keys[:len(keys)-1]
+
+		for _, path := range []string{evicted, fmt.Sprintf("%s.manifest.json", evicted)} {
+			err = os.RemoveAll(filepath.Join(c.layer.Path, path))
+			if err != nil {
+				return fmt.Errorf("failed to evict ICU from cache: %w", err)
+			}
+		}
+
+		delete(entries, evicted)
+	}
+
+	c.setEntries(entries)
+
+	return nil
+}
+
+// Touch marks the cached tree for the checksum as recently used, if present.
+func (c *dependencyCache) Touch(checksum string) {
+	if !c.Enabled() {
+		return
+	}
+
+	key := cacheKey(checksum)
+	entries := c.entries()
+
+	entry, ok := entries[key]
+	if !ok {
+		return
+	}
+
+	entry.LastUsed = c.now().UTC().Format(time.RFC3339Nano)
+	entries[key] = entry
+	c.setEntries(entries)
+}
+
+// Manifest returns the cached tree for the checksum along with the manifest
+// that was generated when it was stored.
+func (c dependencyCache) Manifest(checksum string) (string, Manifest, bool) {
+	if !c.Enabled() || checksum == "" {
+		return "", Manifest{}, false
+	}
+
+	key := cacheKey(checksum)
+	if _, ok := c.entries()[key]; !ok {
+		return "", Manifest{}, false
+	}
+
+	content, err := os.ReadFile(filepath.Join(c.layer.Path, fmt.Sprintf("%s.manifest.json", key)))
+	if err != nil {
+		return "", Manifest{}, false
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(content, &manifest)
+	if err != nil {
+		return "", Manifest{}, false
+	}
+
+	return filepath.Join(c.layer.Path, key), manifest, true
+}
+
+// Layer returns the cache layer to be included in the build result.
+func (c dependencyCache) Layer() packit.Layer {
+	return c.layer
+}
@@ -0,0 +1,149 @@
+package icu
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/paketo-buildpacks/packit/v2/cargo"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+)
+
+//go:generate faux --interface Transport --output fakes/transport.go
+type Transport interface {
+	Drop(root, uri string) (io.ReadCloser, error)
+}
+
+// OpenPGPDependencyVerifier downloads a dependency that is not listed in
+// buildpack.toml and checks it against its checksum and, when a signature URI
+// is given, a detached OpenPGP signature made by a key in the keyring.
+type OpenPGPDependencyVerifier struct {
+	transport Transport
+}
+
+func NewOpenPGPDependencyVerifier(transport Transport) OpenPGPDependencyVerifier {
+	return OpenPGPDependencyVerifier{
+		transport: transport,
+	}
+}
+
+// Fetch writes the dependency into the destination directory and returns the
+// path of the verified file.
+func (v OpenPGPDependencyVerifier) Fetch(dependency postal.Dependency, signatureURI string, keyring []byte, destination string) (string, error) {
+	if signatureURI != "" && len(bytes.TrimSpace(keyring)) == 0 {
+		return "", fmt.Errorf("failed to verify %s: a signature was provided but no keyring is bound", dependency.URI)
+	}
+
+	artifact, err := v.transport.Drop("/", dependency.URI)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch dependency: %w", err)
+	}
+	defer artifact.Close()
+
+	artifactPath := filepath.Join(destination, path.Base(dependency.URI))
+	file, err := os.Create(artifactPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	validatedReader := cargo.NewValidatedReader(artifact, dependency.Checksum)
+	_, err = io.Copy(file, validatedReader)
+	if err != nil {
+		if errors.Is(err, cargo.ErrorChecksumMismatch) {
+			return "", fmt.Errorf("failed to validate dependency: checksum does not match %s", dependency.Checksum)
+		}
+
+		return "", fmt.Errorf("failed to fetch dependency: %w", err)
+	}
+
+	if signatureURI == "" {
+		return artifactPath, nil
+	}
+
+	entities, err := readKeyring(keyring, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := v.transport.Drop("/", signatureURI)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch signature: %w", err)
+	}
+	defer signature.Close()
+
+	signatureContent, err := io.ReadAll(signature)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch signature: %w", err)
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+
+	// the detached signature may be either ASCII armored (.asc) or binary
+	// (.sig)
+	if bytes.HasPrefix(bytes.TrimSpace(signatureContent), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(entities, file, bytes.NewReader(signatureContent), nil)
+	} else {
+		_, err = openpgp.CheckDetachedSignature(entities, file, bytes.NewReader(signatureContent), nil)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to verify signature of %s: %w", dependency.URI, err)
+	}
+
+	return artifactPath, nil
+}
+
+var armoredKeyBlockPattern = regexp.MustCompile(`(?ms)^-----BEGIN PGP PUBLIC KEY BLOCK-----$.*?^-----END PGP PUBLIC KEY BLOCK-----$`)
+
+// readKeyring reads a binary keyring, or the armored key blocks found in it,
+// and keeps the keys that can sign at the given time.
+func readKeyring(keyring []byte, now time.Time) (openpgp.EntityList, error) {
+	entities, err := openpgp.ReadKeyRing(bytes.NewReader(keyring))
+	if err != nil {
+		entities = nil
+		for _, block := range armoredKeyBlockPattern.FindAll(bytes.ReplaceAll(keyring, []byte("\r\n"), []byte("\n")), -1) {
+			list, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(block))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read keyring: %w", err)
+			}
+
+			entities = append(entities, list...)
+		}
+	}
+
+	var (
+		usable   openpgp.EntityList
+		rejected []string
+	)
+	for _, entity := range entities {
+		switch _, ok := entity.SigningKey(now); {
+		case entity.Revoked(now):
+			rejected = append(rejected, fmt.Sprintf("%X: the key is revoked", entity.PrimaryKey.Fingerprint))
+		case !ok:
+			rejected = append(rejected, fmt.Sprintf("%X: the key is expired or cannot sign", entity.PrimaryKey.Fingerprint))
+		default:
+			usable = append(usable, entity)
+		}
+	}
+
+	if len(usable) == 0 {
+		if len(rejected) == 0 {
+			return nil, errors.New("failed to read keyring: no public key found")
+		}
+
+		return nil, fmt.Errorf("failed to read keyring: no key can verify signatures: %s", strings.Join(rejected, "; "))
+	}
+
+	return usable, nil
+}
@@ -0,0 +1,248 @@
+package icu_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/paketo-buildpacks/icu"
+	"github.com/paketo-buildpacks/icu/fakes"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testDependencyVerifier(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		destination string
+		artifact    []byte
+		signature   []byte
+		keyring     []byte
+		dependency  postal.Dependency
+
+		transport *fakes.Transport
+		verifier  icu.OpenPGPDependencyVerifier
+	)
+
+	armoredKey := func(entity *openpgp.Entity) []byte {
+		buffer := bytes.NewBuffer(nil)
+		writer, err := armor.Encode(buffer, openpgp.PublicKeyType, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entity.Serialize(writer)).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+
+		return buffer.Bytes()
+	}
+
+	it.Before(func() {
+		var err error
+		destination, err = os.MkdirTemp("", "destination")
+		Expect(err).NotTo(HaveOccurred())
+
+		artifact = []byte("some-icu-tarball")
+		sum := sha256.Sum256(artifact)
+
+		entity, err := openpgp.NewEntity("Some Signer", "", "signer@example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		keyringBuffer := bytes.NewBuffer(nil)
+		writer, err := armor.Encode(keyringBuffer, openpgp.PublicKeyType, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entity.Serialize(writer)).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+		keyring = keyringBuffer.Bytes()
+
+		signatureBuffer := bytes.NewBuffer(nil)
+		Expect(openpgp.ArmoredDetachSign(signatureBuffer, entity, bytes.NewReader(artifact), nil)).To(Succeed())
+		signature = signatureBuffer.Bytes()
+
+		dependency = postal.Dependency{
+			URI:      "https://example.com/icu_78.3_linux_amd64_noble.tgz",
+			Checksum: fmt.Sprintf("sha256:%s", hex.EncodeToString(sum[:])),
+		}
+
+		transport = &fakes.Transport{}
+		transport.DropCall.Stub = func(root, uri string) (io.ReadCloser, error) {
+			switch uri {
+			case "https://example.com/icu_78.3_linux_amd64_noble.tgz":
+				return io.NopCloser(bytes.NewReader(artifact)), nil
+			case "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc":
+				return io.NopCloser(bytes.NewReader(signature)), nil
+			default:
+				return nil, fmt.Errorf("unexpected uri %q", uri)
+			}
+		}
+
+		verifier = icu.NewOpenPGPDependencyVerifier(transport)
+	})
+
+	it.After(func() {
+		Expect(os.RemoveAll(destination)).To(Succeed())
+	})
+
+	it("fetches the dependency and verifies its checksum and signature", func() {
+		path, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", keyring, destination)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal(filepath.Join(destination, "icu_78.3_linux_amd64_noble.tgz")))
+
+		content, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(content).To(Equal(artifact))
+
+		Expect(transport.DropCall.CallCount).To(Equal(2))
+		Expect(transport.DropCall.Receives.Root).To(Equal("/"))
+	})
+
+	context("when no signature URI is given", func() {
+		it("only verifies the checksum", func() {
+			path, err := verifier.Fetch(dependency, "", nil, destination)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(BeARegularFile())
+			Expect(transport.DropCall.CallCount).To(Equal(1))
+		})
+	})
+
+	context("when the keyring holds several armored blocks", func() {
+		it.Before(func() {
+			other, err := openpgp.NewEntity("Other Signer", "", "other@example.com", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			buffer := bytes.NewBuffer(nil)
+			writer, err := armor.Encode(buffer, openpgp.PublicKeyType, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(other.Serialize(writer)).To(Succeed())
+			Expect(writer.Close()).To(Succeed())
+
+			keyring = append(append(buffer.Bytes(), '\n'), keyring...)
+		})
+
+		it("verifies against any of them", func() {
+			_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", keyring, destination)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	context("when the keyring has CRLF line endings and comments", func() {
+		it.Before(func() {
+			keyring = []byte("# ICU release signing key\r\n" + strings.ReplaceAll(string(keyring), "\n", "\r\n"))
+		})
+
+		it("verifies the signature", func() {
+			_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", keyring, destination)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	context("failure cases", func() {
+		context("when the checksum does not match", func() {
+			it.Before(func() {
+				dependency.Checksum = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+			})
+
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "", nil, destination)
+				Expect(err).To(MatchError(ContainSubstring("failed to validate dependency: checksum does not match")))
+			})
+		})
+
+		context("when the signature was made by a key outside of the keyring", func() {
+			it.Before(func() {
+				other, err := openpgp.NewEntity("Other Signer", "", "other@example.com", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				buffer := bytes.NewBuffer(nil)
+				Expect(openpgp.ArmoredDetachSign(buffer, other, bytes.NewReader(artifact), nil)).To(Succeed())
+				signature = buffer.Bytes()
+			})
+
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", keyring, destination)
+				Expect(err).To(MatchError(ContainSubstring("failed to verify signature of https://example.com/icu_78.3_linux_amd64_noble.tgz")))
+			})
+		})
+
+		context("when the signature is given without a keyring", func() {
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", nil, destination)
+				Expect(err).To(MatchError("failed to verify https://example.com/icu_78.3_linux_amd64_noble.tgz: a signature was provided but no keyring is bound"))
+			})
+		})
+
+		context("when the dependency cannot be fetched", func() {
+			it.Before(func() {
+				transport.DropCall.Stub = nil
+				transport.DropCall.Returns.Error = errors.New("failed to drop")
+			})
+
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "", nil, destination)
+				Expect(err).To(MatchError("failed to fetch dependency: failed to drop"))
+			})
+		})
+
+		context("when the signing key is revoked", func() {
+			it.Before(func() {
+				entity, err := openpgp.NewEntity("Revoked Signer", "", "revoked@example.com", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				buffer := bytes.NewBuffer(nil)
+				Expect(openpgp.ArmoredDetachSign(buffer, entity, bytes.NewReader(artifact), nil)).To(Succeed())
+				signature = buffer.Bytes()
+
+				Expect(entity.RevokeKey(packet.KeyCompromised, "compromised", nil)).To(Succeed())
+				keyring = armoredKey(entity)
+			})
+
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", keyring, destination)
+				Expect(err).To(MatchError(ContainSubstring("failed to read keyring: no key can verify signatures")))
+				Expect(err).To(MatchError(ContainSubstring("the key is revoked")))
+			})
+		})
+
+		context("when the signing key is expired", func() {
+			it.Before(func() {
+				created := time.Now().Add(-48 * time.Hour)
+				config := &packet.Config{
+					Time:            func() time.Time { return created },
+					KeyLifetimeSecs: 3600,
+				}
+
+				entity, err := openpgp.NewEntity("Expired Signer", "", "expired@example.com", config)
+				Expect(err).NotTo(HaveOccurred())
+
+				buffer := bytes.NewBuffer(nil)
+				Expect(openpgp.ArmoredDetachSign(buffer, entity, bytes.NewReader(artifact), config)).To(Succeed())
+				signature = buffer.Bytes()
+
+				keyring = armoredKey(entity)
+			})
+
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", keyring, destination)
+				Expect(err).To(MatchError(ContainSubstring("the key is expired")))
+			})
+		})
+
+		context("when the keyring cannot be read", func() {
+			it("returns an error", func() {
+				_, err := verifier.Fetch(dependency, "https://example.com/icu_78.3_linux_amd64_noble.tgz.asc", []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----\ngarbage\n-----END PGP PUBLIC KEY BLOCK-----"), destination)
+				Expect(err).To(MatchError(ContainSubstring("failed to read keyring")))
+			})
+		})
+	})
+}
@@ -0,0 +1,90 @@
+package icu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+// disabledBy inspects the build environment for an opt-out of the ICU
+// installation. It returns the assignment that disabled ICU (for example
+// "BP_ICU_DISABLE=true") or an empty string when ICU should be installed.
+// Applications running .NET in globalization-invariant mode do not load ICU,
+// so DOTNET_SYSTEM_GLOBALIZATION_INVARIANT is honored in the same way.
+func disabledBy() (string, error) {
+	if value, ok := os.LookupEnv("BP_ICU_DISABLE"); ok {
+		disabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse BP_ICU_DISABLE value %q: %w", value, err)
+		}
+
+		if disabled {
+			return fmt.Sprintf("BP_ICU_DISABLE=%s", value), nil
+		}
+	}
+
+	// .NET treats both "1" and "true" as enabling invariant mode
+	if value, ok := os.LookupEnv("DOTNET_SYSTEM_GLOBALIZATION_INVARIANT"); ok {
+		if value == "1" || strings.EqualFold(value, "true") {
+			return fmt.Sprintf("DOTNET_SYSTEM_GLOBALIZATION_INVARIANT=%s", value), nil
+		}
+	}
+
+	return "", nil
+}
+
+func warnDisabled(logger scribe.Emitter, reason string, entries []packit.BuildpackPlanEntry) {
+	logger.Process("WARNING: ICU installation is disabled by %s", reason)
+	logger.Subprocess("The following requirements for ICU will not be satisfied:")
+
+	for _, entry := range entries {
+		versionSource, ok := entry.Metadata["version-source"].(string)
+		if !ok {
+			versionSource = "<unknown>"
+		}
+
+		version, ok := entry.Metadata["version"].(string)
+		if !ok {
+			version = "*"
+		}
+
+		var phases []string
+		if launch, ok := entry.Metadata["launch"].(bool); ok && launch {
+			phases = append(phases, "launch")
+		}
+
+		if build, ok := entry.Metadata["build"].(bool); ok && build {
+			phases = append(phases, "build")
+		}
+
+		if len(phases) == 0 {
+			logger.Action("%s -> %q", versionSource, version)
+			continue
+		}
+
+		logger.Action("%s -> %q (%s)", versionSource, version, strings.Join(phases, ", "))
+	}
+
+	logger.Break()
+}
+
+// removeLayer deletes a layer and its content metadata so that a layer
+// restored from the cache is not carried forward into the next image.
+func removeLayer(layers packit.Layers, name string) error {
+	err := os.RemoveAll(filepath.Join(layers.Path, name))
+	if err != nil {
+		return fmt.Errorf("failed to remove %s layer: %w", name, err)
+	}
+
+	err = os.Remove(filepath.Join(layers.Path, fmt.Sprintf("%s.toml", name)))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s layer metadata: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,501 @@
+package icu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/cargo"
+	"github.com/paketo-buildpacks/packit/v2/chronos"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+	"github.com/paketo-buildpacks/packit/v2/servicebindings"
+)
+
+const (
+	defaultDownloadRetries  = 3
+	defaultDownloadTimeout  = time.Minute
+	defaultProgressInterval = 5 * time.Second
+	defaultInitialBackoff   = time.Second
+	defaultMaximumBackoff   = 30 * time.Second
+)
+
+// downloadSettings are the number of times an interrupted download is
+// retried, as set by BP_ICU_DOWNLOAD_RETRIES, how long a download may stall
+// before it is retried, as set by BP_ICU_DOWNLOAD_TIMEOUT, and how often its
+// progress is reported, as set by BP_ICU_DOWNLOAD_PROGRESS_INTERVAL.
+type downloadSettings struct {
+	retries          int
+	timeout          time.Duration
+	progressInterval time.Duration
+}
+
+// readDownloadSettings reads the download settings from the environment,
+// falling back to the defaults and the given progress interval. Durations are
+// Go durations such as "90s" or a number of seconds; a timeout of 0 disables
+// it, and a progress interval of 0 reports every read.
+func readDownloadSettings(progressInterval time.Duration) (downloadSettings, error) {
+	settings := downloadSettings{
+		retries:          defaultDownloadRetries,
+		timeout:          defaultDownloadTimeout,
+		progressInterval: progressInterval,
+	}
+
+	if value, ok := os.LookupEnv("BP_ICU_DOWNLOAD_RETRIES"); ok && value != "" {
+		var err error
+		settings.retries, err = strconv.Atoi(value)
+		if err != nil {
+			return downloadSettings{}, fmt.Errorf("failed to parse BP_ICU_DOWNLOAD_RETRIES value %q: %w", value, err)
+		}
+
+		if settings.retries < 0 {
+			return downloadSettings{}, fmt.Errorf("failed to parse BP_ICU_DOWNLOAD_RETRIES value %q: must not be negative", value)
+		}
+	}
+
+	var err error
+	settings.timeout, err = durationSetting("BP_ICU_DOWNLOAD_TIMEOUT", settings.timeout)
+	if err != nil {
+		return downloadSettings{}, err
+	}
+
+	settings.progressInterval, err = durationSetting("BP_ICU_DOWNLOAD_PROGRESS_INTERVAL", settings.progressInterval)
+	if err != nil {
+		return downloadSettings{}, err
+	}
+
+	return settings, nil
+}
+
+func durationSetting(name string, fallback time.Duration) (time.Duration, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+
+	seconds, err := strconv.Atoi(value)
+	duration := time.Duration(seconds) * time.Second
+	if err != nil {
+		duration, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %s value %q: %w", name, value, err)
+		}
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("failed to parse %s value %q: must not be negative", name, value)
+	}
+
+	return duration, nil
+}
+
+// ResumableDependencyManager is a postal.Service whose downloads survive
+// dropped connections. Interrupted or stalled transfers are retried with
+// exponential backoff and resumed from where they stopped using HTTP Range
+// requests, and the progress of each transfer is reported periodically.
+// Tarballs are decompressed and extracted concurrently by extractArchive.
+type ResumableDependencyManager struct {
+	client          *http.Client
+	bindingResolver BindingResolver
+	clock           chronos.Clock
+	logger          scribe.Emitter
+
+	progressInterval time.Duration
+	initialBackoff   time.Duration
+	maximumBackoff   time.Duration
+}
+
+func NewResumableDependencyManager(clock chronos.Clock, logger scribe.Emitter) ResumableDependencyManager {
+	return ResumableDependencyManager{
+		client:           http.DefaultClient,
+		bindingResolver:  servicebindings.NewResolver(),
+		clock:            clock,
+		logger:           logger,
+		progressInterval: defaultProgressInterval,
+		initialBackoff:   defaultInitialBackoff,
+		maximumBackoff:   defaultMaximumBackoff,
+	}
+}
+
+// WithProgressInterval sets how often progress is reported when
+// BP_ICU_DOWNLOAD_PROGRESS_INTERVAL is not set.
+func (m ResumableDependencyManager) WithProgressInterval(interval time.Duration) ResumableDependencyManager {
+	m.progressInterval = interval
+	return m
+}
+
+func (m ResumableDependencyManager) WithBackoff(initial, maximum time.Duration) ResumableDependencyManager {
+	m.initialBackoff = initial
+	m.maximumBackoff = maximum
+	return m
+}
+
+func (m ResumableDependencyManager) Resolve(path, id, version, stack string) (postal.Dependency, error) {
+	return postal.NewService(cargo.NewTransport()).Resolve(path, id, version, stack)
+}
+
+func (m ResumableDependencyManager) GenerateBillOfMaterials(dependencies ...postal.Dependency) []packit.BOMEntry {
+	return postal.NewService(cargo.NewTransport()).GenerateBillOfMaterials(dependencies...)
+}
+
+// Deliver delivers the dependency like postal.Service does, including
+// dependency mappings and mirrors, checksum validation and extraction, but
+// fetches it through a transport that retries and resumes. The checksum is
+// computed while the dependency is streamed into extractArchive, so the
+// download is read exactly once.
+func (m ResumableDependencyManager) Deliver(dependency postal.Dependency, cnbPath, layerPath, platformPath string) error {
+	settings, err := readDownloadSettings(m.progressInterval)
+	if err != nil {
+		return err
+	}
+
+	transport := resumableTransport{
+		manager:  m,
+		settings: settings,
+	}
+
+	checksum := dependency.Checksum
+	if dependency.SHA256 != "" {
+		checksum = fmt.Sprintf("sha256:%s", dependency.SHA256)
+	}
+
+	uri, err := dependencyURI(m.bindingResolver, dependency.URI, checksum, platformPath)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := transport.Drop(cnbPath, uri)
+	if err != nil {
+		return fmt.Errorf("failed to fetch dependency: %s", err)
+	}
+	defer bundle.Close()
+
+	name := dependency.Name
+	if name == "" {
+		name = filepath.Base(uri)
+	}
+
+	validatedReader := cargo.NewValidatedReader(bundle, checksum)
+	err = extractArchive(validatedReader, name, layerPath, dependency.StripComponents)
+	if err != nil {
+		if errors.Is(err, cargo.ErrorChecksumMismatch) {
+			return errors.New("failed to validate dependency: checksum does not match")
+		}
+
+		return err
+	}
+
+	ok, err := validatedReader.Valid()
+	if err != nil {
+		return fmt.Errorf("failed to validate dependency: %s", err)
+	}
+
+	if !ok {
+		return errors.New("failed to validate dependency: checksum does not match")
+	}
+
+	return nil
+}
+
+type resumableTransport struct {
+	manager  ResumableDependencyManager
+	settings downloadSettings
+}
+
+func (t resumableTransport) Drop(root, uri string) (io.ReadCloser, error) {
+	if strings.HasPrefix(uri, "file://") {
+		file, err := os.Open(filepath.Join(root, strings.TrimPrefix(uri, "file://")))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %s", err)
+		}
+
+		return file, nil
+	}
+
+	body := &resumableBody{
+		transport: t,
+		uri:       uri,
+		size:      -1,
+	}
+
+	// the first request is made eagerly so that a dependency that cannot be
+	// fetched at all fails in Drop, as it does with the cargo transport
+	err := body.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// retryableError marks failures that are worth retrying, as opposed to
+// responses such as 404 that will not change.
+type retryableError struct {
+	err error
+}
+
+func (e retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e retryableError) Unwrap() error {
+	return e.err
+}
+
+type resumableBody struct {
+	transport resumableTransport
+	uri       string
+
+	response *http.Response
+	cancel   context.CancelFunc
+	timer    *time.Timer
+
+	// validator is the ETag or Last-Modified value of the first response,
+	// which makes sure that a resumed transfer continues the same content
+	validator string
+
+	offset int64
+	size   int64
+
+	failures     int
+	lastReported time.Time
+}
+
+// connect makes requests until one succeeds or the retries are exhausted.
+func (b *resumableBody) connect() error {
+	for {
+		err := b.open()
+		if err == nil {
+			return nil
+		}
+
+		err = b.backoff(err)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// backoff waits before the next attempt, or returns the error when it cannot
+// be retried.
+func (b *resumableBody) backoff(err error) error {
+	b.close()
+
+	var retryable retryableError
+	if !errors.As(err, &retryable) || b.failures >= b.transport.settings.retries {
+		return err
+	}
+
+	delay := b.transport.manager.initialBackoff << b.failures
+	if delay > b.transport.manager.maximumBackoff || delay <= 0 {
+		delay = b.transport.manager.maximumBackoff
+	}
+	b.failures++
+
+	b.transport.manager.logger.Action("Download interrupted: %s", err)
+	b.transport.manager.logger.Action("Retrying in %s (attempt %d of %d)", delay, b.failures, b.transport.settings.retries)
+	time.Sleep(delay)
+
+	return nil
+}
+
+func (b *resumableBody) open() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	request, err := http.NewRequestWithContext(ctx, "GET", b.uri, nil)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to parse request uri: %s", err)
+	}
+
+	if b.offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", b.offset))
+		if b.validator != "" {
+			request.Header.Set("If-Range", b.validator)
+		}
+	}
+
+	b.cancel = cancel
+	if b.transport.settings.timeout > 0 {
+		b.timer = time.AfterFunc(b.transport.settings.timeout, cancel)
+	}
+
+	response, err := b.transport.manager.client.Do(request)
+	if err != nil {
+		return retryableError{fmt.Errorf("failed to make request: %s", err)}
+	}
+	b.response = response
+
+	switch {
+	case response.StatusCode == http.StatusPartialContent && b.offset > 0:
+		start, err := contentRangeStart(response.Header.Get("Content-Range"))
+		if err != nil || start != b.offset {
+			return fmt.Errorf("unexpected Content-Range %q while resuming %q at byte %d", response.Header.Get("Content-Range"), b.uri, b.offset)
+		}
+
+		b.transport.manager.logger.Action("Resuming download at %s", formatBytes(b.offset))
+
+	case response.StatusCode == http.StatusOK:
+		b.size = response.ContentLength
+		if b.offset == 0 {
+			b.validator = response.Header.Get("ETag")
+			if b.validator == "" {
+				b.validator = response.Header.Get("Last-Modified")
+			}
+			break
+		}
+
+		// the server does not support ranges, so the part that has already
+		// been read is skipped
+		b.transport.manager.logger.Action("Server does not support resuming, restarting download")
+		_, err := io.CopyN(io.Discard, b.body(), b.offset)
+		if err != nil {
+			return retryableError{fmt.Errorf("failed to skip %d bytes: %w", b.offset, err)}
+		}
+
+	case response.StatusCode == http.StatusRequestTimeout,
+		response.StatusCode == http.StatusTooManyRequests,
+		response.StatusCode >= 500:
+		return retryableError{fmt.Errorf("unexpected status code %d while fetching %q", response.StatusCode, b.uri)}
+
+	default:
+		return fmt.Errorf("unexpected status code %d while fetching %q", response.StatusCode, b.uri)
+	}
+
+	if b.lastReported.IsZero() {
+		b.lastReported = b.transport.manager.clock.Now()
+	}
+
+	return nil
+}
+
+// body returns the response body, postponing the stall timeout on each read.
+func (b *resumableBody) body() io.Reader {
+	return readerFunc(func(p []byte) (int, error) {
+		if b.timer != nil {
+			b.timer.Reset(b.transport.settings.timeout)
+		}
+
+		return b.response.Body.Read(p)
+	})
+}
+
+func (b *resumableBody) Read(p []byte) (int, error) {
+	for {
+		if b.response == nil {
+			err := b.connect()
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := b.body().Read(p)
+		b.offset += int64(n)
+		if n > 0 {
+			b.failures = 0
+			b.report()
+		}
+
+		switch {
+		case err == nil:
+			return n, nil
+
+		case errors.Is(err, io.EOF) && (b.size < 0 || b.offset >= b.size):
+			b.report()
+			return n, io.EOF
+
+		case errors.Is(err, io.EOF):
+			err = io.ErrUnexpectedEOF
+		}
+
+		err = b.backoff(retryableError{fmt.Errorf("failed to read response after %s: %w", formatBytes(b.offset), err)})
+		if err != nil {
+			return n, err
+		}
+
+		// the data read so far is returned before the transfer is resumed
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+func (b *resumableBody) report() {
+	manager := b.transport.manager
+
+	now := manager.clock.Now()
+	if now.Sub(b.lastReported) < b.transport.settings.progressInterval && (b.size < 0 || b.offset < b.size) {
+		return
+	}
+	b.lastReported = now
+
+	if b.size > 0 {
+		manager.logger.Action("Downloaded %s of %s (%d%%)", formatBytes(b.offset), formatBytes(b.size), b.offset*100/b.size)
+		return
+	}
+
+	manager.logger.Action("Downloaded %s", formatBytes(b.offset))
+}
+
+func (b *resumableBody) close() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if b.response != nil {
+		_ = b.response.Body.Close()
+		b.response = nil
+	}
+
+	if b.cancel != nil {
+		b.cancel()
+		b.cancel = nil
+	}
+}
+
+func (b *resumableBody) Close() error {
+	b.close()
+	return nil
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+// contentRangeStart parses the first byte position of a Content-Range header
+// such as "bytes 100-199/200".
+func contentRangeStart(header string) (int64, error) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, fmt.Errorf("unsupported Content-Range %q", header)
+	}
+
+	start, _, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, fmt.Errorf("unsupported Content-Range %q", header)
+	}
+
+	return strconv.ParseInt(start, 10, 64)
+}
+
+func formatBytes(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	}
+
+	return fmt.Sprintf("%d B", size)
+}
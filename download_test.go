@@ -0,0 +1,336 @@
+package icu_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paketo-buildpacks/icu"
+	"github.com/paketo-buildpacks/packit/v2/chronos"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testDownload(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		layerPath string
+		content   []byte
+
+		server *httptest.Server
+
+		mutex    sync.Mutex
+		requests []*http.Request
+		handlers []http.HandlerFunc
+
+		dependency postal.Dependency
+		buffer     *bytes.Buffer
+		manager    icu.ResumableDependencyManager
+	)
+
+	serve := func(w http.ResponseWriter, req *http.Request) {
+		body := content
+		if value := req.Header.Get("Range"); value != "" {
+			start, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(value, "bytes="), "-"))
+			Expect(err).NotTo(HaveOccurred())
+
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(content)-1, len(content)))
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)-start))
+			w.WriteHeader(http.StatusPartialContent)
+			body = content[start:]
+		} else {
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		}
+
+		_, _ = w.Write(body)
+	}
+
+	// drop writes half of the content and closes the connection
+	drop := func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Header().Set("ETag", `"some-etag"`)
+		_, _ = w.Write(content[:len(content)/2])
+	}
+
+	status := func(code int) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(code)
+		}
+	}
+
+	it.Before(func() {
+		var err error
+		layerPath, err = os.MkdirTemp("", "layer")
+		Expect(err).NotTo(HaveOccurred())
+
+		content = bytes.Repeat([]byte("some ICU content\n"), 16*1024)
+		sum := sha256.Sum256(content)
+
+		requests = nil
+		handlers = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			requests = append(requests, req)
+			handler := http.HandlerFunc(serve)
+			if len(handlers) > 0 {
+				handler, handlers = handlers[0], handlers[1:]
+			}
+			mutex.Unlock()
+
+			handler(w, req)
+		}))
+
+		dependency = postal.Dependency{
+			ID:       "icu",
+			Name:     "icu.txt",
+			Checksum: fmt.Sprintf("sha256:%s", hex.EncodeToString(sum[:])),
+			URI:      fmt.Sprintf("%s/icu.txt", server.URL),
+		}
+
+		buffer = bytes.NewBuffer(nil)
+		manager = icu.NewResumableDependencyManager(chronos.DefaultClock, scribe.NewEmitter(buffer)).
+			WithBackoff(time.Millisecond, 10*time.Millisecond)
+	})
+
+	it.After(func() {
+		server.Close()
+		Expect(os.RemoveAll(layerPath)).To(Succeed())
+	})
+
+	it("delivers the dependency", func() {
+		err := manager.Deliver(dependency, "", layerPath, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+		Expect(requests).To(HaveLen(1))
+	})
+
+	context("when the connection drops during the transfer", func() {
+		it.Before(func() {
+			handlers = []http.HandlerFunc{drop}
+		})
+
+		it("resumes the transfer where it stopped", func() {
+			err := manager.Deliver(dependency, "", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[1].Header.Get("Range")).To(Equal(fmt.Sprintf("bytes=%d-", len(content)/2)))
+			Expect(requests[1].Header.Get("If-Range")).To(Equal(`"some-etag"`))
+
+			Expect(buffer.String()).To(ContainSubstring("Download interrupted: failed to read response after 136.0 KB"))
+			Expect(buffer.String()).To(ContainSubstring("Retrying in 1ms (attempt 1 of 3)"))
+			Expect(buffer.String()).To(ContainSubstring("Resuming download at 136.0 KB"))
+		})
+
+		context("when the server does not support ranges", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{drop, func(w http.ResponseWriter, req *http.Request) {
+					req.Header.Del("Range")
+					serve(w, req)
+				}}
+			})
+
+			it("skips the part that was already read", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+				Expect(buffer.String()).To(ContainSubstring("Server does not support resuming, restarting download"))
+			})
+		})
+	})
+
+	context("when the transfer stalls", func() {
+		it.Before(func() {
+			t.Setenv("BP_ICU_DOWNLOAD_TIMEOUT", "100ms")
+
+			handlers = []http.HandlerFunc{func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+				_, _ = w.Write(content[:len(content)/2])
+				w.(http.Flusher).Flush()
+
+				select {
+				case <-req.Context().Done():
+				case <-time.After(5 * time.Second):
+				}
+			}}
+		})
+
+		it("resumes the transfer", func() {
+			err := manager.Deliver(dependency, "", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[1].Header.Get("Range")).To(Equal(fmt.Sprintf("bytes=%d-", len(content)/2)))
+		})
+	}, spec.Sequential())
+
+	context("when the server is temporarily unavailable", func() {
+		it.Before(func() {
+			handlers = []http.HandlerFunc{status(http.StatusServiceUnavailable), status(http.StatusTooManyRequests)}
+		})
+
+		it("retries with backoff", func() {
+			err := manager.Deliver(dependency, "", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+			Expect(requests).To(HaveLen(3))
+			Expect(buffer.String()).To(ContainSubstring("Retrying in 1ms (attempt 1 of 3)"))
+			Expect(buffer.String()).To(ContainSubstring("Retrying in 2ms (attempt 2 of 3)"))
+		})
+	})
+
+	context("when progress is reported", func() {
+		it.Before(func() {
+			manager = manager.WithProgressInterval(0)
+		})
+
+		it("reports the transferred size", func() {
+			err := manager.Deliver(dependency, "", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buffer.String()).To(ContainSubstring("Downloaded 272.0 KB of 272.0 KB (100%)"))
+		})
+	})
+
+	context("when BP_ICU_DOWNLOAD_PROGRESS_INTERVAL is set", func() {
+		it.Before(func() {
+			t.Setenv("BP_ICU_DOWNLOAD_PROGRESS_INTERVAL", "0")
+		})
+
+		it("reports progress at that interval", func() {
+			err := manager.Deliver(dependency, "", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buffer.String()).To(ContainSubstring("Downloaded 272.0 KB of 272.0 KB (100%)"))
+		})
+	}, spec.Sequential())
+
+	context("when a dependency mirror is set", func() {
+		var mirrorDir string
+
+		it.Before(func() {
+			mirrorDir = t.TempDir()
+			Expect(os.WriteFile(filepath.Join(mirrorDir, "icu.txt"), content, 0644)).To(Succeed())
+
+			t.Setenv("BP_DEPENDENCY_MIRROR", fmt.Sprintf("file://%s", mirrorDir))
+		})
+
+		it("delivers the dependency from the mirror", func() {
+			err := manager.Deliver(dependency, "/", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+			Expect(requests).To(BeEmpty())
+		})
+	}, spec.Sequential())
+
+	context("when the dependency is a local file", func() {
+		it.Before(func() {
+			Expect(os.WriteFile(filepath.Join(layerPath, "source.txt"), content, 0644)).To(Succeed())
+			dependency.URI = fmt.Sprintf("file://%s", filepath.Join(layerPath, "source.txt"))
+		})
+
+		it("delivers it without a request", func() {
+			err := manager.Deliver(dependency, "/", layerPath, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.ReadFile(filepath.Join(layerPath, "icu.txt"))).To(Equal(content))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+
+	context("failure cases", func() {
+		context("when the retries are exhausted", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DOWNLOAD_RETRIES", "1")
+				handlers = []http.HandlerFunc{status(http.StatusBadGateway), status(http.StatusBadGateway)}
+			})
+
+			it("returns an error", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).To(MatchError(ContainSubstring("unexpected status code 502")))
+				Expect(requests).To(HaveLen(2))
+			})
+		}, spec.Sequential())
+
+		context("when the dependency does not exist", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{status(http.StatusNotFound)}
+			})
+
+			it("does not retry", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+				Expect(requests).To(HaveLen(1))
+			})
+		})
+
+		context("when the resumed content does not match the checksum", func() {
+			it.Before(func() {
+				handlers = []http.HandlerFunc{drop, func(w http.ResponseWriter, req *http.Request) {
+					content = bytes.ToUpper(content)
+					serve(w, req)
+				}}
+			})
+
+			it("returns an error", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).To(MatchError(ContainSubstring("checksum does not match")))
+			})
+		})
+
+		context("when BP_ICU_DOWNLOAD_RETRIES is invalid", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DOWNLOAD_RETRIES", "-1")
+			})
+
+			it("returns an error", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).To(MatchError(`failed to parse BP_ICU_DOWNLOAD_RETRIES value "-1": must not be negative`))
+			})
+		}, spec.Sequential())
+
+		context("when BP_ICU_DOWNLOAD_PROGRESS_INTERVAL is invalid", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DOWNLOAD_PROGRESS_INTERVAL", "-5s")
+			})
+
+			it("returns an error", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).To(MatchError(`failed to parse BP_ICU_DOWNLOAD_PROGRESS_INTERVAL value "-5s": must not be negative`))
+			})
+		}, spec.Sequential())
+
+		context("when BP_ICU_DOWNLOAD_TIMEOUT is invalid", func() {
+			it.Before(func() {
+				t.Setenv("BP_ICU_DOWNLOAD_TIMEOUT", "soon")
+			})
+
+			it("returns an error", func() {
+				err := manager.Deliver(dependency, "", layerPath, "")
+				Expect(err).To(MatchError(ContainSubstring(`failed to parse BP_ICU_DOWNLOAD_TIMEOUT value "soon"`)))
+			})
+		}, spec.Sequential())
+	})
+}
@@ -0,0 +1,243 @@
+package icu
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/paketo-buildpacks/packit/v2/vacation"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// bufferedFileLimit is the size up to which the content of a file is read
+// into memory and written by a worker while the tarball is read further.
+// Larger files are written directly from the tarball stream.
+const bufferedFileLimit = 4 << 20
+
+// extractArchive decompresses gzip- and zstd-compressed tarballs into the
+// destination. Decompression runs concurrently with reading the stream and
+// files are written by a pool of workers, so that the extraction of a large
+// tarball is not bound to a single core. Any other archive is handed to
+// vacation, which also handles single files that are named after the
+// dependency.
+func extractArchive(reader io.Reader, name, destination string, components int) error {
+	workers := runtime.GOMAXPROCS(0)
+
+	compressed := bufio.NewReaderSize(reader, 1<<16)
+	magic, _ := compressed.Peek(len(zstdMagic))
+
+	var decompressed io.Reader
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gzipReader, err := pgzip.NewReaderN(compressed, 1<<20, workers)
+		if err != nil {
+			return fmt.Errorf("failed to create gzip reader: %w", err)
+		}
+		defer gzipReader.Close()
+
+		decompressed = gzipReader
+
+	case bytes.HasPrefix(magic, zstdMagic):
+		zstdReader, err := zstd.NewReader(compressed, zstd.WithDecoderConcurrency(workers))
+		if err != nil {
+			return fmt.Errorf("failed to create zstd reader: %w", err)
+		}
+		defer zstdReader.Close()
+
+		decompressed = zstdReader
+
+	default:
+		return vacation.NewArchive(compressed).WithName(name).StripComponents(components).Decompress(destination)
+	}
+
+	archive := bufio.NewReaderSize(decompressed, 1<<16)
+	header, _ := archive.Peek(262)
+	if len(header) < 262 || !bytes.Equal(header[257:262], []byte("ustar")) {
+		return vacation.NewArchive(archive).WithName(name).StripComponents(components).Decompress(destination)
+	}
+
+	return extractTarball(archive, destination, components, workers)
+}
+
+type archivedLink struct {
+	name string
+	path string
+}
+
+func extractTarball(reader io.Reader, destination string, components, workers int) error {
+	group, ctx := errgroup.WithContext(context.Background())
+	group.SetLimit(workers)
+
+	directories := map[string]bool{}
+	mkdir := func(path string) error {
+		if directories[path] {
+			return nil
+		}
+
+		err := os.MkdirAll(path, os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("failed to create archived directory: %w", err)
+		}
+
+		directories[path] = true
+		return nil
+	}
+
+	var symlinks, links []archivedLink
+
+	err := func() error {
+		tarReader := tar.NewReader(reader)
+		for ctx.Err() == nil {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read tar response: %w", err)
+			}
+
+			path, ok, err := archivedPath(header.Name, destination, components)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				continue
+			}
+
+			if header.Typeflag == tar.TypeDir {
+				err = mkdir(path)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			err = mkdir(filepath.Dir(path))
+			if err != nil {
+				return err
+			}
+
+			switch header.Typeflag {
+			case tar.TypeReg:
+				mode := header.FileInfo().Mode()
+
+				if header.Size > bufferedFileLimit {
+					err = writeArchivedFile(path, mode, tarReader)
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				content := make([]byte, header.Size)
+				_, err = io.ReadFull(tarReader, content)
+				if err != nil {
+					return fmt.Errorf("failed to read tar response: %w", err)
+				}
+
+				group.Go(func() error {
+					return writeArchivedFile(path, mode, bytes.NewReader(content))
+				})
+
+			case tar.TypeSymlink:
+				target := filepath.Join(filepath.Dir(path), header.Linkname)
+				if filepath.IsAbs(header.Linkname) || !strings.HasPrefix(target, filepath.Clean(destination)+string(filepath.Separator)) {
+					return fmt.Errorf("illegal symlink %q: the link target does not occur within the destination directory", header.Name)
+				}
+
+				symlinks = append(symlinks, archivedLink{name: header.Linkname, path: path})
+
+			case tar.TypeLink:
+				target, ok, err := archivedPath(header.Linkname, destination, components)
+				if err != nil {
+					return err
+				}
+
+				if ok {
+					links = append(links, archivedLink{name: target, path: path})
+				}
+			}
+		}
+
+		return nil
+	}()
+
+	// The workers are waited for even when reading the tarball failed, so that
+	// none of them is still writing into the destination after this returns.
+	waitErr := group.Wait()
+	if err == nil {
+		err = waitErr
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, link := range symlinks {
+		err = os.Symlink(link.name, link.path)
+		if err != nil {
+			return fmt.Errorf("failed to extract symlink: %w", err)
+		}
+	}
+
+	for _, link := range links {
+		err = os.Link(link.name, link.path)
+		if err != nil {
+			return fmt.Errorf("failed to extract link: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// archivedPath returns the destination of an archived file after stripping
+// the leading path components, and reports false when nothing is left.
+func archivedPath(name, destination string, components int) (string, bool, error) {
+	name = filepath.Clean(name)
+	if name == "." {
+		return "", false, nil
+	}
+
+	path := filepath.Join(destination, name)
+	if !strings.HasPrefix(path, filepath.Clean(destination)+string(filepath.Separator)) {
+		return "", false, fmt.Errorf("illegal file path %q: the file path does not occur within the destination directory", name)
+	}
+
+	parts := strings.Split(filepath.ToSlash(name), "/")
+	if len(parts) <= components {
+		return "", false, nil
+	}
+
+	return filepath.Join(append([]string{destination}, parts[components:]...)...), true, nil
+}
+
+func writeArchivedFile(path string, mode os.FileMode, content io.Reader) error {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return fmt.Errorf("failed to create archived file: %w", err)
+	}
+
+	_, err = io.Copy(file, content)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write archived file: %w", err)
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,279 @@
+package icu_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/paketo-buildpacks/icu"
+	"github.com/paketo-buildpacks/packit/v2/cargo"
+	"github.com/paketo-buildpacks/packit/v2/chronos"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+type tarballEntry struct {
+	Header  tar.Header
+	Content []byte
+}
+
+func writeTarball(format string, entries []tarballEntry) ([]byte, error) {
+	buffer := bytes.NewBuffer(nil)
+
+	var compressor io.WriteCloser
+	switch format {
+	case "zstd":
+		encoder, err := zstd.NewWriter(buffer)
+		if err != nil {
+			return nil, err
+		}
+		compressor = encoder
+
+	default:
+		compressor = gzip.NewWriter(buffer)
+	}
+
+	tw := tar.NewWriter(compressor)
+	for _, entry := range entries {
+		header := entry.Header
+		header.Size = int64(len(entry.Content))
+		if header.Mode == 0 {
+			header.Mode = 0644
+		}
+
+		err := tw.WriteHeader(&header)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tw.Write(entry.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := tw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = compressor.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func checksumOf(content []byte) string {
+	sum := sha256.Sum256(content)
+	return fmt.Sprintf("sha256:%s", hex.EncodeToString(sum[:]))
+}
+
+func testExtract(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		sourceDir string
+		layerPath string
+
+		largeFile []byte
+		entries   []tarballEntry
+
+		manager icu.ResumableDependencyManager
+	)
+
+	deliver := func(format string) error {
+		tarball, err := writeTarball(format, entries)
+		Expect(err).NotTo(HaveOccurred())
+
+		path := filepath.Join(sourceDir, fmt.Sprintf("icu.%s", format))
+		Expect(os.WriteFile(path, tarball, 0644)).To(Succeed())
+
+		return manager.Deliver(postal.Dependency{
+			ID:              "icu",
+			Name:            "ICU",
+			Checksum:        checksumOf(tarball),
+			URI:             fmt.Sprintf("file://%s", path),
+			StripComponents: 1,
+		}, "/", layerPath, "")
+	}
+
+	it.Before(func() {
+		var err error
+		sourceDir, err = os.MkdirTemp("", "source")
+		Expect(err).NotTo(HaveOccurred())
+
+		layerPath, err = os.MkdirTemp("", "layer")
+		Expect(err).NotTo(HaveOccurred())
+
+		largeFile = make([]byte, 6<<20)
+		rand.New(rand.NewSource(0)).Read(largeFile)
+
+		entries = []tarballEntry{
+			{Header: tar.Header{Name: "icu/", Typeflag: tar.TypeDir, Mode: 0755}},
+			{Header: tar.Header{Name: "icu/include/unicode/uchar.h", Typeflag: tar.TypeReg}, Content: []byte("header")},
+			{Header: tar.Header{Name: "icu/lib/libicuuc.so.78.3", Typeflag: tar.TypeReg, Mode: 0755}, Content: []byte("library")},
+			{Header: tar.Header{Name: "icu/lib/libicuuc.so", Typeflag: tar.TypeSymlink, Linkname: "libicuuc.so.78.3"}},
+			{Header: tar.Header{Name: "icu/lib/libicuuc.so.78", Typeflag: tar.TypeLink, Linkname: "icu/lib/libicuuc.so.78.3"}},
+			{Header: tar.Header{Name: "icu/share/icu/78.3/icudt78l.dat", Typeflag: tar.TypeReg}, Content: largeFile},
+		}
+
+		manager = icu.NewResumableDependencyManager(chronos.DefaultClock, scribe.NewEmitter(io.Discard))
+	})
+
+	it.After(func() {
+		Expect(os.RemoveAll(sourceDir)).To(Succeed())
+		Expect(os.RemoveAll(layerPath)).To(Succeed())
+	})
+
+	for _, format := range []string{"gzip", "zstd"} {
+		format := format
+
+		context(fmt.Sprintf("when the dependency is a %s tarball", format), func() {
+			it("extracts it into the layer", func() {
+				Expect(deliver(format)).To(Succeed())
+
+				Expect(os.ReadFile(filepath.Join(layerPath, "include", "unicode", "uchar.h"))).To(Equal([]byte("header")))
+				Expect(os.ReadFile(filepath.Join(layerPath, "lib", "libicuuc.so.78"))).To(Equal([]byte("library")))
+				Expect(os.Readlink(filepath.Join(layerPath, "lib", "libicuuc.so"))).To(Equal("libicuuc.so.78.3"))
+				Expect(os.ReadFile(filepath.Join(layerPath, "share", "icu", "78.3", "icudt78l.dat"))).To(Equal(largeFile))
+
+				info, err := os.Stat(filepath.Join(layerPath, "lib", "libicuuc.so.78.3"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+			})
+		})
+	}
+
+	context("failure cases", func() {
+		context("when the tarball does not match the checksum", func() {
+			it("returns an error", func() {
+				tarball, err := writeTarball("gzip", entries)
+				Expect(err).NotTo(HaveOccurred())
+
+				path := filepath.Join(sourceDir, "icu.tgz")
+				Expect(os.WriteFile(path, tarball, 0644)).To(Succeed())
+
+				err = manager.Deliver(postal.Dependency{
+					ID:       "icu",
+					Checksum: checksumOf([]byte("something else")),
+					URI:      fmt.Sprintf("file://%s", path),
+				}, "/", layerPath, "")
+				Expect(err).To(MatchError("failed to validate dependency: checksum does not match"))
+			})
+		})
+
+		context("when a file path escapes the layer", func() {
+			it.Before(func() {
+				entries = append(entries, tarballEntry{Header: tar.Header{Name: "icu/../../escape", Typeflag: tar.TypeReg}, Content: []byte("escape")})
+			})
+
+			it("returns an error", func() {
+				err := deliver("gzip")
+				Expect(err).To(MatchError(ContainSubstring(`illegal file path "../escape"`)))
+			})
+
+			it("waits for the files that were already read to be written", func() {
+				Expect(deliver("zstd")).NotTo(Succeed())
+
+				Expect(os.ReadFile(filepath.Join(layerPath, "include", "unicode", "uchar.h"))).To(Equal([]byte("header")))
+				Expect(os.ReadFile(filepath.Join(layerPath, "lib", "libicuuc.so.78.3"))).To(Equal([]byte("library")))
+			})
+		})
+
+		context("when a symlink points outside of the layer", func() {
+			it.Before(func() {
+				entries = append(entries, tarballEntry{Header: tar.Header{Name: "icu/lib/escape", Typeflag: tar.TypeSymlink, Linkname: "../../../etc/passwd"}})
+			})
+
+			it("returns an error", func() {
+				err := deliver("zstd")
+				Expect(err).To(MatchError(ContainSubstring(`illegal symlink "icu/lib/escape"`)))
+			})
+		})
+	})
+}
+
+// benchmarkTarball writes a tarball shaped like an ICU artifact: a large data
+// package alongside many small headers and libraries.
+func benchmarkTarball(b *testing.B, format string) (string, string) {
+	random := rand.New(rand.NewSource(0))
+
+	data := make([]byte, 32<<20)
+	for i := range data {
+		// compressible, like the ICU data package
+		data[i] = byte(random.Intn(16))
+	}
+
+	entries := []tarballEntry{{Header: tar.Header{Name: "icu/share/icu/78.3/icudt78l.dat", Typeflag: tar.TypeReg}, Content: data}}
+	for i := 0; i < 400; i++ {
+		content := make([]byte, 16<<10)
+		random.Read(content[:len(content)/4])
+
+		entries = append(entries, tarballEntry{Header: tar.Header{Name: fmt.Sprintf("icu/include/unicode/header%d.h", i), Typeflag: tar.TypeReg}, Content: content})
+	}
+
+	tarball, err := writeTarball(format, entries)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	path := filepath.Join(b.TempDir(), "icu.tarball")
+	err = os.WriteFile(path, tarball, 0644)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return path, checksumOf(tarball)
+}
+
+func benchmarkDeliver(b *testing.B, manager icu.DependencyManager, format string) {
+	path, checksum := benchmarkTarball(b, format)
+
+	dependency := postal.Dependency{
+		ID:              "icu",
+		Name:            "ICU",
+		Checksum:        checksum,
+		URI:             fmt.Sprintf("file://%s", path),
+		StripComponents: 1,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		layerPath := b.TempDir()
+		b.StartTimer()
+
+		err := manager.Deliver(dependency, "/", layerPath, "")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDeliver(b *testing.B) {
+	b.Run("postal.Service/gzip", func(b *testing.B) {
+		benchmarkDeliver(b, postal.NewService(cargo.NewTransport()), "gzip")
+	})
+
+	b.Run("ResumableDependencyManager/gzip", func(b *testing.B) {
+		benchmarkDeliver(b, icu.NewResumableDependencyManager(chronos.DefaultClock, scribe.NewEmitter(io.Discard)), "gzip")
+	})
+
+	b.Run("ResumableDependencyManager/zstd", func(b *testing.B) {
+		benchmarkDeliver(b, icu.NewResumableDependencyManager(chronos.DefaultClock, scribe.NewEmitter(io.Discard)), "zstd")
+	})
+}
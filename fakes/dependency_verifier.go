@@ -0,0 +1,39 @@
+package fakes
+
+import (
+	"sync"
+
+	"github.com/paketo-buildpacks/packit/v2/postal"
+)
+
+type DependencyVerifier struct {
+	FetchCall struct {
+		mutex     sync.Mutex
+		CallCount int
+		Receives  struct {
+			Dependency   postal.Dependency
+			SignatureURI string
+			Keyring      []byte
+			Destination  string
+		}
+		Returns struct {
+			String string
+			Error  error
+		}
+		Stub func(postal.Dependency, string, []byte, string) (string, error)
+	}
+}
+
+func (f *DependencyVerifier) Fetch(param1 postal.Dependency, param2 string, param3 []byte, param4 string) (string, error) {
+	f.FetchCall.mutex.Lock()
+	defer f.FetchCall.mutex.Unlock()
+	f.FetchCall.CallCount++
+	f.FetchCall.Receives.Dependency = param1
+	f.FetchCall.Receives.SignatureURI = param2
+	f.FetchCall.Receives.Keyring = param3
+	f.FetchCall.Receives.Destination = param4
+	if f.FetchCall.Stub != nil {
+		return f.FetchCall.Stub(param1, param2, param3, param4)
+	}
+	return f.FetchCall.Returns.String, f.FetchCall.Returns.Error
+}
@@ -0,0 +1,41 @@
+package fakes
+
+import (
+	"sync"
+
+	"github.com/paketo-buildpacks/icu"
+)
+
+type LayerPatcher struct {
+	PatchCall struct {
+		mutex     sync.Mutex
+		CallCount int
+		Receives  struct {
+			Previous     icu.Manifest
+			Next         icu.Manifest
+			PreviousPath string
+			LayerPath    string
+			PlatformPath string
+		}
+		Returns struct {
+			Int   int
+			Error error
+		}
+		Stub func(icu.Manifest, icu.Manifest, string, string, string) (int, error)
+	}
+}
+
+func (f *LayerPatcher) Patch(param1 icu.Manifest, param2 icu.Manifest, param3 string, param4 string, param5 string) (int, error) {
+	f.PatchCall.mutex.Lock()
+	defer f.PatchCall.mutex.Unlock()
+	f.PatchCall.CallCount++
+	f.PatchCall.Receives.Previous = param1
+	f.PatchCall.Receives.Next = param2
+	f.PatchCall.Receives.PreviousPath = param3
+	f.PatchCall.Receives.LayerPath = param4
+	f.PatchCall.Receives.PlatformPath = param5
+	if f.PatchCall.Stub != nil {
+		return f.PatchCall.Stub(param1, param2, param3, param4, param5)
+	}
+	return f.PatchCall.Returns.Int, f.PatchCall.Returns.Error
+}
@@ -11,5 +11,9 @@ func TestUnitIcu(t *testing.T) {
 	suite := spec.New("icu", spec.Report(report.Terminal{}), spec.Parallel())
 	suite("Build", testBuild)
 	suite("Detect", testDetect)
+	suite("Download", testDownload)
+	suite("Extract", testExtract)
+	suite("DependencyVerifier", testDependencyVerifier)
+	suite("Manifest", testManifest)
 	suite.Run(t)
 }
@@ -0,0 +1,153 @@
+package integration_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/paketo-buildpacks/occam"
+	"github.com/paketo-buildpacks/packit/v2/cargo"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testFormats(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect     = NewWithT(t).Expect
+		Eventually = NewWithT(t).Eventually
+
+		pack   occam.Pack
+		docker occam.Docker
+
+		dependency cargo.ConfigMetadataDependency
+		tarball    []byte
+	)
+
+	it.Before(func() {
+		pack = occam.NewPack()
+		docker = occam.NewDocker()
+
+		builder, err := pack.Builder.Inspect.Execute()
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err := cargo.NewBuildpackParser().Parse(filepath.Join("..", "buildpack.toml"))
+		Expect(err).NotTo(HaveOccurred())
+
+		dependency = cargo.ConfigMetadataDependency{}
+		for _, d := range config.Metadata.Dependencies {
+			if d.ID != "icu" || d.Arch != runtime.GOARCH {
+				continue
+			}
+
+			for _, stack := range d.Stacks {
+				if stack == builder.LocalInfo.Stack.ID {
+					dependency = d
+				}
+			}
+		}
+		Expect(dependency.URI).NotTo(BeEmpty(), fmt.Sprintf("no icu dependency for %s", builder.LocalInfo.Stack.ID))
+
+		response, err := http.Get(dependency.URI)
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		tarball, err = io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	for _, format := range []string{"gzip", "zstd"} {
+		format := format
+
+		context(fmt.Sprintf("when the ICU dependency is a %s tarball", format), func() {
+			var (
+				image     occam.Image
+				container occam.Container
+				name      string
+				source    string
+			)
+
+			it.Before(func() {
+				var err error
+				name, err = occam.RandomName()
+				Expect(err).NotTo(HaveOccurred())
+
+				source, err = occam.Source(filepath.Join("testdata", "default_app"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			it.After(func() {
+				Expect(docker.Container.Remove.Execute(container.ID)).To(Succeed())
+				Expect(docker.Image.Remove.Execute(image.ID)).To(Succeed())
+				Expect(docker.Volume.Remove.Execute(occam.CacheVolumeNames(name))).To(Succeed())
+				Expect(os.RemoveAll(source)).To(Succeed())
+			})
+
+			it("installs ICU from the tarball", func() {
+				file := "icu.tgz"
+				content := tarball
+
+				if format == "zstd" {
+					file = "icu.tar.zst"
+
+					gzipReader, err := gzip.NewReader(bytes.NewReader(tarball))
+					Expect(err).NotTo(HaveOccurred())
+
+					buffer := bytes.NewBuffer(nil)
+					zstdWriter, err := zstd.NewWriter(buffer)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = io.Copy(zstdWriter, gzipReader)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(zstdWriter.Close()).To(Succeed())
+
+					content = buffer.Bytes()
+				}
+
+				Expect(os.WriteFile(filepath.Join(source, file), content, 0644)).To(Succeed())
+				sum := sha256.Sum256(content)
+
+				var (
+					err  error
+					logs fmt.Stringer
+				)
+				image, logs, err = pack.WithNoColor().Build.
+					WithPullPolicy("never").
+					WithBuildpacks(
+						buildpack,
+						buildPlanBuildpack,
+					).
+					WithEnv(map[string]string{
+						"BP_ICU_DEPENDENCY_URI":      fmt.Sprintf("file:///workspace/%s", file),
+						"BP_ICU_DEPENDENCY_CHECKSUM": fmt.Sprintf("sha256:%s", hex.EncodeToString(sum[:])),
+						"BP_ICU_DEPENDENCY_VERSION":  dependency.Version,
+					}).
+					Execute(name, source)
+				Expect(err).NotTo(HaveOccurred(), logs.String())
+
+				Expect(logs.String()).To(ContainSubstring("Installing ICU"))
+
+				container, err = docker.Container.Run.
+					WithCommand("icuinfo && sleep infinity").
+					Execute(image.ID)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(func() string {
+					cLogs, err := docker.Container.Logs.Execute(container.ID)
+					Expect(err).NotTo(HaveOccurred())
+					return cLogs.String()
+				}).Should(ContainSubstring(fmt.Sprintf(`<param name="version">%s</param>`, dependency.Version)))
+			})
+		})
+	}
+}
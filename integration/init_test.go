@@ -70,6 +70,7 @@ func TestIntegration(t *testing.T) {
 
 	suite := spec.New("Integration", spec.Report(report.Terminal{}), spec.Parallel())
 	suite("Default", testDefault)
+	suite("Formats", testFormats)
 	suite("Offline", testOffline)
 	suite("RebuildLayerReuse", testRebuildLayerReuse)
 	suite.Run(t)
@@ -0,0 +1,137 @@
+package icu
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/paketo-buildpacks/packit/v2"
+)
+
+// layerTransaction replaces layers without destroying their previous
+// contents until the build has succeeded. New contents are written to a
+// staging directory next to the layer and swapped in by Commit; the previous
+// contents are kept aside so that Rollback can restore them if the build
+// fails later on. The staged contents are normalized to the timestamp when
+// they are committed, so that they do not depend on when they were written.
+type layerTransaction struct {
+	timestamp time.Time
+	committed []packit.Layer
+}
+
+func stagingPath(layer packit.Layer) string {
+	return filepath.Join(filepath.Dir(layer.Path), fmt.Sprintf(".%s.staging", layer.Name))
+}
+
+func backupPath(layer packit.Layer) string {
+	return filepath.Join(filepath.Dir(layer.Path), fmt.Sprintf(".%s.previous", layer.Name))
+}
+
+// Stage returns the layer with its types, environment and metadata reset, and
+// an empty staging directory to deliver the new contents into. The layer
+// directory itself is left untouched.
+func (t *layerTransaction) Stage(layer packit.Layer) (packit.Layer, string, error) {
+	staged := layer
+	staged.Path = stagingPath(layer)
+
+	staged, err := staged.Reset()
+	if err != nil {
+		return packit.Layer{}, "", fmt.Errorf("failed to stage %s layer: %w", layer.Name, err)
+	}
+
+	staged.Path = layer.Path
+
+	return staged, stagingPath(layer), nil
+}
+
+// Commit normalizes the staged contents and swaps the staging directory in as
+// the layer directory.
+func (t *layerTransaction) Commit(layer packit.Layer) error {
+	err := normalizeLayer(stagingPath(layer), t.timestamp)
+	if err != nil {
+		return fmt.Errorf("failed to commit %s layer: %w", layer.Name, err)
+	}
+
+	backup := backupPath(layer)
+
+	err = os.RemoveAll(backup)
+	if err != nil {
+		return fmt.Errorf("failed to commit %s layer: %w", layer.Name, err)
+	}
+
+	err = os.Rename(layer.Path, backup)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to commit %s layer: %w", layer.Name, err)
+	}
+
+	err = os.Rename(stagingPath(layer), layer.Path)
+	if err != nil {
+		return errors.Join(
+			fmt.Errorf("failed to commit %s layer: %w", layer.Name, err),
+			restore(layer),
+		)
+	}
+
+	t.committed = append(t.committed, layer)
+
+	return nil
+}
+
+// Rollback discards any staged contents and restores the previous contents
+// of every committed layer.
+func (t *layerTransaction) Rollback(layers packit.Layers) error {
+	var errs error
+
+	entries, err := os.ReadDir(layers.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = errors.Join(errs, err)
+	}
+
+	for _, entry := range entries {
+		if matched, _ := filepath.Match(".*.staging", entry.Name()); matched {
+			errs = errors.Join(errs, os.RemoveAll(filepath.Join(layers.Path, entry.Name())))
+		}
+	}
+
+	for i := len(t.committed) - 1; i >= 0; i-- {
+		layer := t.committed[i]
+
+		err = os.RemoveAll(layer.Path)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to roll back %s layer: %w", layer.Name, err))
+			continue
+		}
+
+		errs = errors.Join(errs, restore(layer))
+	}
+
+	t.committed = nil
+
+	return errs
+}
+
+// Close removes the previous contents of the committed layers once the build
+// has succeeded.
+func (t *layerTransaction) Close() error {
+	for _, layer := range t.committed {
+		err := os.RemoveAll(backupPath(layer))
+		if err != nil {
+			return fmt.Errorf("failed to remove previous %s layer: %w", layer.Name, err)
+		}
+	}
+
+	t.committed = nil
+
+	return nil
+}
+
+func restore(layer packit.Layer) error {
+	err := os.Rename(backupPath(layer), layer.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to roll back %s layer: %w", layer.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,366 @@
+package icu
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+// Manifest lists every file of an extracted ICU dependency with its sha256.
+// It is published by the compile pipeline as the icu-manifest dependency,
+// along with a content-addressed store of the files.
+type Manifest struct {
+	Version string `json:"version"`
+
+	// Objects is the URI of the content-addressed store holding each file at
+	// <Objects>/<sha256>. A relative URI is resolved against the manifest URI.
+	Objects string `json:"objects"`
+
+	Files []ManifestFile `json:"files"`
+}
+
+type ManifestFile struct {
+	Path string `json:"path"`
+
+	// Mode holds the octal permission bits of regular files.
+	Mode   string `json:"mode,omitempty"`
+	SHA256 string `json:"sha256,omitempty"`
+
+	// Link is the target of a symbolic link.
+	Link string `json:"link,omitempty"`
+}
+
+//go:generate faux --interface LayerPatcher --output fakes/layer_patcher.go
+type LayerPatcher interface {
+	Patch(previous, next Manifest, previousPath, layerPath, platformPath string) (int, error)
+}
+
+// ManifestLayerPatcher builds the tree described by the next manifest from the
+// unchanged files of the previous tree and the changed files in the
+// content-addressed store.
+type ManifestLayerPatcher struct {
+	transport       Transport
+	bindingResolver BindingResolver
+}
+
+func NewManifestLayerPatcher(transport Transport, bindingResolver BindingResolver) ManifestLayerPatcher {
+	return ManifestLayerPatcher{
+		transport:       transport,
+		bindingResolver: bindingResolver,
+	}
+}
+
+// Patch writes the next tree into the layer and returns the number of files
+// fetched from the store. Objects are fetched through dependency mappings and
+// mirrors as dependencies are. Every file is checked against its sha256, so
+// any inconsistency in the previous tree or the store results in an error.
+func (p ManifestLayerPatcher) Patch(previous, next Manifest, previousPath, layerPath, platformPath string) (int, error) {
+	previousFiles := map[string]ManifestFile{}
+	for _, file := range previous.Files {
+		previousFiles[file.Path] = file
+	}
+
+	var fetched int
+	for _, file := range next.Files {
+		destination, err := securePath(layerPath, file.Path)
+		if err != nil {
+			return fetched, err
+		}
+
+		err = os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+		if err != nil {
+			return fetched, err
+		}
+
+		if file.Link != "" {
+			err = os.Symlink(file.Link, destination)
+			if err != nil {
+				return fetched, err
+			}
+
+			continue
+		}
+
+		mode, err := strconv.ParseUint(file.Mode, 8, 32)
+		if err != nil {
+			return fetched, fmt.Errorf("invalid mode %q for %s in manifest: %w", file.Mode, file.Path, err)
+		}
+
+		var source io.ReadCloser
+		if previousFile, ok := previousFiles[file.Path]; ok && previousFile.SHA256 == file.SHA256 && previousFile.Link == "" {
+			previousFilePath, err := securePath(previousPath, file.Path)
+			if err != nil {
+				return fetched, err
+			}
+
+			source, err = os.Open(previousFilePath)
+			if err != nil {
+				return fetched, err
+			}
+		} else {
+			objectURI, err := resolveObjectURI(next.Objects, file.SHA256)
+			if err != nil {
+				return fetched, err
+			}
+
+			objectURI, err = dependencyURI(p.bindingResolver, objectURI, fmt.Sprintf("sha256:%s", file.SHA256), platformPath)
+			if err != nil {
+				return fetched, err
+			}
+
+			source, err = p.transport.Drop("", objectURI)
+			if err != nil {
+				return fetched, fmt.Errorf("failed to fetch %s: %w", file.Path, err)
+			}
+
+			fetched++
+		}
+
+		err = writeVerifiedFile(source, destination, os.FileMode(mode), file.SHA256)
+		if err != nil {
+			return fetched, fmt.Errorf("failed to patch %s: %w", file.Path, err)
+		}
+	}
+
+	return fetched, nil
+}
+
+func writeVerifiedFile(source io.ReadCloser, destination string, mode os.FileMode, checksum string) error {
+	defer source.Close()
+
+	file, err := os.OpenFile(destination, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), source)
+	if err != nil {
+		return err
+	}
+
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != checksum {
+		return fmt.Errorf("sha256 %s does not match manifest sha256 %s", sum, checksum)
+	}
+
+	return nil
+}
+
+// securePath joins a manifest path to a root and rejects paths that would
+// escape it.
+func securePath(root, path string) (string, error) {
+	joined := filepath.Join(root, path)
+	if !strings.HasPrefix(joined, filepath.Clean(root)+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid path %q in manifest", path)
+	}
+
+	return joined, nil
+}
+
+func resolveObjectURI(base, checksum string) (string, error) {
+	if base == "" {
+		return "", errors.New("manifest does not declare an object store")
+	}
+
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+
+	baseURI, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse object store URI: %w", err)
+	}
+
+	return baseURI.ResolveReference(&url.URL{Path: checksum}).String(), nil
+}
+
+// generateManifest describes the tree at root in the same way as the
+// manifests published by the compile pipeline.
+func generateManifest(root string) (Manifest, error) {
+	var manifest Manifest
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		if entry.Type()&fs.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+
+			manifest.Files = append(manifest.Files, ManifestFile{Path: filepath.ToSlash(rel), Link: link})
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		hash := sha256.New()
+		_, err = io.Copy(hash, file)
+		if err != nil {
+			return err
+		}
+
+		manifest.Files = append(manifest.Files, ManifestFile{
+			Path:   filepath.ToSlash(rel),
+			Mode:   fmt.Sprintf("%04o", info.Mode().Perm()),
+			SHA256: hex.EncodeToString(hash.Sum(nil)),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+// patchFromPrevious upgrades the layer incrementally from the tree of the
+// previously installed dependency, which is taken from the dependency cache
+// when it holds that tree and from the previous layer otherwise. It reports
+// false, leaving an empty layer, when the upgrade is not possible or anything
+// is inconsistent so that the dependency is delivered in full instead.
+func patchFromPrevious(context packit.BuildContext,
+	dependencyManager DependencyManager,
+	layerPatcher LayerPatcher,
+	cache *dependencyCache,
+	logger scribe.Emitter,
+	previousChecksum string,
+	dependency postal.Dependency,
+	previousLayerPath string,
+	layerPath string,
+) (bool, error) {
+	source := "the cached tree"
+	previousPath, previous, cached := cache.Manifest(previousChecksum)
+	if !cached {
+		// the files of a layer that is not cached are not restored
+		entries, err := os.ReadDir(previousLayerPath)
+		if err != nil || len(entries) == 0 {
+			return false, nil
+		}
+
+		source = "the previous layer"
+		previousPath = previousLayerPath
+	}
+
+	// a missing manifest is not an error since it only enables incremental
+	// upgrades
+	manifestDependency, err := dependencyManager.Resolve(filepath.Join(context.CNBPath, "buildpack.toml"), ICUManifestDependency, dependency.Version, context.Stack)
+	if err != nil {
+		var noDeps *postal.ErrNoDeps
+		if errors.As(err, &noDeps) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to resolve ICU manifest: %w", err)
+	}
+
+	next, err := fetchManifest(context, dependencyManager, manifestDependency)
+	if err != nil {
+		logger.Action("Incremental upgrade is not possible: %s", err)
+		return false, nil
+	}
+
+	if !cached {
+		previous, err = generateManifest(previousPath)
+		if err != nil {
+			logger.Action("Incremental upgrade is not possible: %s", err)
+			return false, nil
+		}
+	}
+
+	fetched, err := layerPatcher.Patch(previous, *next, previousPath, layerPath, context.Platform.Path)
+	if err != nil {
+		logger.Action("Incremental upgrade failed, falling back to a full installation: %s", err)
+
+		err = os.RemoveAll(layerPath)
+		if err != nil {
+			return false, err
+		}
+
+		return false, os.MkdirAll(layerPath, os.ModePerm)
+	}
+
+	logger.Action("Upgraded incrementally from %s, fetched %d of %d files", source, fetched, len(next.Files))
+
+	return true, nil
+}
+
+// fetchManifest delivers the icu-manifest dependency into a temporary
+// directory and parses it.
+func fetchManifest(context packit.BuildContext, dependencyManager DependencyManager, dependency postal.Dependency) (*Manifest, error) {
+	dependency.Name = "manifest.json"
+
+	dir, err := os.MkdirTemp("", "icu-manifest")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	err = dependencyManager.Deliver(dependency, context.CNBPath, dir, context.Platform.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ICU manifest: %w", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ICU manifest: %w", err)
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(content, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ICU manifest: %w", err)
+	}
+
+	// relative object stores are resolved against the manifest URI so that
+	// the store can be published next to it
+	if manifest.Objects != "" {
+		objects, err := url.Parse(manifest.Objects)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ICU manifest: %w", err)
+		}
+
+		manifestURI, err := url.Parse(dependency.URI)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ICU manifest: %w", err)
+		}
+
+		manifest.Objects = manifestURI.ResolveReference(objects).String()
+	}
+
+	return &manifest, nil
+}
@@ -0,0 +1,186 @@
+package icu_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/paketo-buildpacks/icu"
+	"github.com/paketo-buildpacks/icu/fakes"
+	"github.com/sclevine/spec"
+
+	. "github.com/onsi/gomega"
+)
+
+func testManifest(t *testing.T, context spec.G, it spec.S) {
+	var (
+		Expect = NewWithT(t).Expect
+
+		previousPath string
+		layerPath    string
+		objects      map[string][]byte
+
+		previous icu.Manifest
+		next     icu.Manifest
+
+		transport *fakes.Transport
+		patcher   icu.ManifestLayerPatcher
+	)
+
+	sha := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+
+	it.Before(func() {
+		var err error
+		previousPath, err = os.MkdirTemp("", "previous")
+		Expect(err).NotTo(HaveOccurred())
+
+		layerPath, err = os.MkdirTemp("", "layer")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(previousPath, "include", "unicode"), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(previousPath, "include", "unicode", "uchar.h"), []byte("unchanged"), 0644)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(previousPath, "lib"), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(previousPath, "lib", "libicuuc.so.78.2"), []byte("old-library"), 0755)).To(Succeed())
+
+		previous = icu.Manifest{
+			Version: "78.2",
+			Files: []icu.ManifestFile{
+				{Path: "include/unicode/uchar.h", Mode: "0644", SHA256: sha("unchanged")},
+				{Path: "lib/libicuuc.so.78.2", Mode: "0755", SHA256: sha("old-library")},
+			},
+		}
+
+		next = icu.Manifest{
+			Version: "78.3",
+			Objects: "https://example.com/icu/objects",
+			Files: []icu.ManifestFile{
+				{Path: "include/unicode/uchar.h", Mode: "0644", SHA256: sha("unchanged")},
+				{Path: "lib/libicuuc.so.78.3", Mode: "0755", SHA256: sha("new-library")},
+				{Path: "lib/libicuuc.so", Link: "libicuuc.so.78.3"},
+			},
+		}
+
+		objects = map[string][]byte{
+			fmt.Sprintf("https://example.com/icu/objects/%s", sha("new-library")): []byte("new-library"),
+		}
+
+		transport = &fakes.Transport{}
+		transport.DropCall.Stub = func(root, uri string) (io.ReadCloser, error) {
+			content, ok := objects[uri]
+			if !ok {
+				return nil, fmt.Errorf("unexpected uri %q", uri)
+			}
+
+			return io.NopCloser(bytes.NewReader(content)), nil
+		}
+
+		patcher = icu.NewManifestLayerPatcher(transport, &fakes.BindingResolver{})
+	})
+
+	it.After(func() {
+		Expect(os.RemoveAll(previousPath)).To(Succeed())
+		Expect(os.RemoveAll(layerPath)).To(Succeed())
+	})
+
+	it("reuses unchanged files and fetches the changed files from the store", func() {
+		fetched, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fetched).To(Equal(1))
+
+		Expect(os.ReadFile(filepath.Join(layerPath, "include", "unicode", "uchar.h"))).To(Equal([]byte("unchanged")))
+		Expect(os.ReadFile(filepath.Join(layerPath, "lib", "libicuuc.so.78.3"))).To(Equal([]byte("new-library")))
+		Expect(os.Readlink(filepath.Join(layerPath, "lib", "libicuuc.so"))).To(Equal("libicuuc.so.78.3"))
+		Expect(filepath.Join(layerPath, "lib", "libicuuc.so.78.2")).NotTo(BeAnExistingFile())
+
+		info, err := os.Stat(filepath.Join(layerPath, "lib", "libicuuc.so.78.3"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+		Expect(transport.DropCall.CallCount).To(Equal(1))
+	})
+
+	context("when a dependency mirror is set", func() {
+		it.Before(func() {
+			t.Setenv("BP_DEPENDENCY_MIRROR", "https://mirror.example.com/{originalHost}")
+
+			objects = map[string][]byte{
+				fmt.Sprintf("https://mirror.example.com/example.com/icu/objects/%s", sha("new-library")): []byte("new-library"),
+			}
+		})
+
+		it("fetches the changed files from the mirror", func() {
+			fetched, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetched).To(Equal(1))
+
+			Expect(os.ReadFile(filepath.Join(layerPath, "lib", "libicuuc.so.78.3"))).To(Equal([]byte("new-library")))
+		})
+	}, spec.Sequential())
+
+	context("failure cases", func() {
+		context("when a file in the previous tree was modified", func() {
+			it.Before(func() {
+				Expect(os.WriteFile(filepath.Join(previousPath, "include", "unicode", "uchar.h"), []byte("modified"), 0644)).To(Succeed())
+			})
+
+			it("returns an error", func() {
+				_, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+				Expect(err).To(MatchError(ContainSubstring("failed to patch include/unicode/uchar.h: sha256")))
+			})
+		})
+
+		context("when an object in the store does not match the manifest", func() {
+			it.Before(func() {
+				objects[fmt.Sprintf("https://example.com/icu/objects/%s", sha("new-library"))] = []byte("tampered")
+			})
+
+			it("returns an error", func() {
+				_, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+				Expect(err).To(MatchError(ContainSubstring("failed to patch lib/libicuuc.so.78.3: sha256")))
+			})
+		})
+
+		context("when an object cannot be fetched", func() {
+			it.Before(func() {
+				transport.DropCall.Stub = nil
+				transport.DropCall.Returns.Error = errors.New("failed to drop")
+			})
+
+			it("returns an error", func() {
+				_, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+				Expect(err).To(MatchError("failed to fetch lib/libicuuc.so.78.3: failed to drop"))
+			})
+		})
+
+		context("when the manifest does not declare an object store", func() {
+			it.Before(func() {
+				next.Objects = ""
+			})
+
+			it("returns an error", func() {
+				_, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+				Expect(err).To(MatchError("manifest does not declare an object store"))
+			})
+		})
+
+		context("when a manifest path escapes the layer", func() {
+			it.Before(func() {
+				next.Files = []icu.ManifestFile{{Path: "../escape", Mode: "0644", SHA256: sha("unchanged")}}
+			})
+
+			it("returns an error", func() {
+				_, err := patcher.Patch(previous, next, previousPath, layerPath, "platform")
+				Expect(err).To(MatchError(`invalid path "../escape" in manifest`))
+			})
+		})
+	})
+}
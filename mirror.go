@@ -0,0 +1,169 @@
+package icu
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/paketo-buildpacks/packit/v2/cargo"
+)
+
+// dependencyURI returns the URI that a file with the given checksum is
+// fetched from, in the same way as postal.Service: the URI of a
+// dependency-mapping binding entry for the checksum, or else the URI rewritten
+// for a mirror set by BP_DEPENDENCY_MIRROR or a dependency-mirror binding.
+func dependencyURI(bindingResolver BindingResolver, uri, checksum, platformPath string) (string, error) {
+	mapping, err := dependencyMapping(bindingResolver, checksum, platformPath)
+	if err != nil {
+		return "", err
+	}
+
+	if mapping != "" {
+		return mapping, nil
+	}
+
+	return mirroredURI(bindingResolver, uri, platformPath)
+}
+
+// dependencyMapping returns the URI of the dependency-mapping binding entry
+// named after the checksum, as <algorithm>:<hash>, <algorithm>_<hash> or, for
+// sha256, the bare hash.
+func dependencyMapping(bindingResolver BindingResolver, checksum, platformPath string) (string, error) {
+	if checksum == "" {
+		return "", nil
+	}
+
+	bindings, err := bindingResolver.Resolve("dependency-mapping", "", platformPath)
+	if err != nil {
+		return "", fmt.Errorf("failure checking for dependency mappings: %w", err)
+	}
+
+	names := []string{checksum, strings.Replace(checksum, ":", "_", 1)}
+	if cargo.Checksum(checksum).Algorithm() == "sha256" {
+		names = append(names, cargo.Checksum(checksum).Hash())
+	}
+
+	for _, binding := range bindings {
+		for _, name := range names {
+			if entry, ok := binding.Entries[name]; ok {
+				content, err := entry.ReadString()
+				if err != nil {
+					return "", fmt.Errorf("failure checking for dependency mappings: %w", err)
+				}
+
+				return strings.TrimSpace(content), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// mirroredURI returns the URI rewritten for the mirror of its host, or the
+// default mirror, from BP_DEPENDENCY_MIRROR_<HOST> and BP_DEPENDENCY_MIRROR
+// or else from a dependency-mirror binding. The URI is returned as is when no
+// mirror is set.
+func mirroredURI(bindingResolver BindingResolver, uri, platformPath string) (string, error) {
+	mirror := mirrorFromEnv(uri)
+	if mirror == "" {
+		bindings, err := bindingResolver.Resolve("dependency-mirror", "", platformPath)
+		if err != nil {
+			return "", fmt.Errorf("failure checking for dependency mirror: %w", err)
+		}
+
+		if len(bindings) > 1 {
+			return "", fmt.Errorf("failure checking for dependency mirror: cannot have multiple bindings of type 'dependency-mirror'")
+		}
+
+		for _, binding := range bindings {
+			for name, entry := range binding.Entries {
+				if name != "default" && !strings.Contains(uri, name) {
+					continue
+				}
+
+				mirror, err = entry.ReadString()
+				if err != nil {
+					return "", fmt.Errorf("failure checking for dependency mirror: %w", err)
+				}
+
+				if name != "default" {
+					break
+				}
+			}
+		}
+	}
+
+	if mirror == "" {
+		return uri, nil
+	}
+
+	return applyMirror(strings.TrimSpace(mirror), uri)
+}
+
+func mirrorFromEnv(uri string) string {
+	var mirror string
+	for _, variable := range os.Environ() {
+		name, value, _ := strings.Cut(variable, "=")
+
+		if name == "BP_DEPENDENCY_MIRROR" {
+			mirror = value
+			continue
+		}
+
+		host, ok := strings.CutPrefix(name, "BP_DEPENDENCY_MIRROR_")
+		if !ok {
+			continue
+		}
+
+		host = strings.ToLower(strings.ReplaceAll(strings.ReplaceAll(host, "__", "-"), "_", "."))
+		if strings.Contains(uri, host) {
+			return value
+		}
+	}
+
+	return mirror
+}
+
+// applyMirror rewrites the URI for a mirror given as a URI, in which
+// {originalHost} stands for the host of the URI, optionally followed by a
+// skip-path argument naming a prefix to drop from the path of the URI.
+func applyMirror(mirror, uri string) (string, error) {
+	base, skipPath := mirror, ""
+	for _, argument := range strings.Split(mirror, ",") {
+		name, value, ok := strings.Cut(argument, "=")
+		switch {
+		case !ok && (strings.HasPrefix(name, "https") || strings.HasPrefix(name, "file")):
+			base = name
+		case name == "mirror":
+			base = value
+		case name == "skip-path":
+			skipPath = value
+		}
+	}
+
+	if unescaped, err := url.PathUnescape(base); err == nil {
+		base = unescaped
+	}
+	if unescaped, err := url.PathUnescape(skipPath); err == nil {
+		skipPath = unescaped
+	}
+
+	mirrorURL, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("failure checking for dependency mirror: %w", err)
+	}
+
+	if scheme := strings.ToLower(mirrorURL.Scheme); scheme != "https" && scheme != "file" {
+		return "", fmt.Errorf("failure checking for dependency mirror: invalid mirror scheme")
+	}
+
+	original, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("failure checking for dependency mirror: %w", err)
+	}
+
+	mirrorURL.Path = strings.Replace(mirrorURL.Path, "{originalHost}", original.Hostname(), 1) + strings.Replace(original.Path, skipPath, "", 1)
+
+	return mirrorURL.String(), nil
+}
@@ -0,0 +1,169 @@
+package icu
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/pexec"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+//go:generate faux --interface Executable --output fakes/executable.go
+type Executable interface {
+	Execute(pexec.Execution) error
+}
+
+// nodeDataDirectory returns the directory within the ICU layer that holds the
+// icudt<major>l.dat common data package, for NODE_ICU_DATA, and whether the
+// package had to be generated. Dependencies that do not ship the package have
+// their common data embedded in libicudata, which is extracted and written
+// out as a little-endian package, the format that Node.js loads, with the
+// delivered icupkg tool.
+func nodeDataDirectory(icupkg Executable, layerPath, version string) (string, bool, error) {
+	directory := filepath.Join("share", "icu", version)
+	name := fmt.Sprintf("icudt%sl.dat", majorVersion(version))
+
+	_, err := os.Stat(filepath.Join(layerPath, directory, name))
+	if err == nil {
+		return directory, false, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", false, err
+	}
+
+	library := filepath.Join("lib", fmt.Sprintf("libicudata.so.%s", version))
+	data, err := commonData(filepath.Join(layerPath, library), majorVersion(version))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", false, fmt.Errorf("failed to configure Node.js ICU data: the ICU dependency includes neither %s nor %s", filepath.Join(directory, name), library)
+		}
+
+		return "", false, fmt.Errorf("failed to configure Node.js ICU data: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "icu-node-data")
+	if err != nil {
+		return "", false, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	source := filepath.Join(tmpDir, name)
+	err = os.WriteFile(source, data, 0644)
+	if err != nil {
+		return "", false, err
+	}
+
+	err = os.MkdirAll(filepath.Join(layerPath, directory), os.ModePerm)
+	if err != nil {
+		return "", false, err
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	err = icupkg.Execute(pexec.Execution{
+		Args: []string{"--type", "l", source, filepath.Join(layerPath, directory, name)},
+		Env: append(os.Environ(),
+			fmt.Sprintf("PATH=%s", strings.Join([]string{filepath.Join(layerPath, "sbin"), filepath.Join(layerPath, "bin"), os.Getenv("PATH")}, string(os.PathListSeparator))),
+			fmt.Sprintf("LD_LIBRARY_PATH=%s", filepath.Join(layerPath, "lib")),
+		),
+		Stdout: buffer,
+		Stderr: buffer,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to generate Node.js ICU data: %w\n%s", err, buffer.String())
+	}
+
+	return directory, true, nil
+}
+
+// commonData returns the common data package that libicudata embeds as the
+// icudt<major>_dat symbol.
+func commonData(path, major string) ([]byte, error) {
+	library, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer library.Close()
+
+	symbols, err := library.DynamicSymbols()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read symbols of %s: %w", filepath.Base(path), err)
+	}
+
+	name := fmt.Sprintf("icudt%s_dat", major)
+	for _, symbol := range symbols {
+		if symbol.Name != name {
+			continue
+		}
+
+		if int(symbol.Section) >= len(library.Sections) {
+			break
+		}
+
+		section := library.Sections[symbol.Section]
+		data := make([]byte, symbol.Size)
+		_, err = section.ReadAt(data, int64(symbol.Value-section.Addr))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from %s: %w", name, filepath.Base(path), err)
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("%s does not define %s", filepath.Base(path), name)
+}
+
+// nodeDataRequested reports whether any plan entry asks for Node.js-style ICU
+// data or BP_ICU_NODE_DATA is enabled.
+func nodeDataRequested(entries []packit.BuildpackPlanEntry) (bool, error) {
+	if value, ok := os.LookupEnv("BP_ICU_NODE_DATA"); ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse BP_ICU_NODE_DATA value %q: %w", value, err)
+		}
+
+		if enabled {
+			return true, nil
+		}
+	}
+
+	for _, entry := range entries {
+		if nodeData, ok := entry.Metadata["node-data"].(bool); ok && nodeData {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// warnNodeICUVersion warns when a plan entry gives the ICU version that the
+// Node.js runtime was built against, as node-icu-version, and its major
+// version differs from the one being installed, since Node.js refuses to load
+// data files of another major version.
+func warnNodeICUVersion(entries []packit.BuildpackPlanEntry, logger scribe.Emitter, version string) {
+	for _, entry := range entries {
+		nodeICUVersion, ok := entry.Metadata["node-icu-version"].(string)
+		if !ok || nodeICUVersion == "" {
+			continue
+		}
+
+		if majorVersion(nodeICUVersion) != majorVersion(version) {
+			logger.Subprocess("WARNING: Node.js expects ICU %s data but ICU %s is being installed", majorVersion(nodeICUVersion), majorVersion(version))
+			logger.Action("Node.js will ignore NODE_ICU_DATA; request an ICU version matching %s.*", majorVersion(nodeICUVersion))
+			logger.Break()
+		}
+
+		return
+	}
+}
+
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
@@ -0,0 +1,215 @@
+package icu
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+var overrideDataPattern = regexp.MustCompile(`^icudt(\d+)[lb]\.dat$`)
+
+// resourceTrees are the ICU data trees that individual .res files can be
+// placed in. A binding entry named "<tree>-<name>.res" is installed as
+// <tree>/<name>.res; any other .res entry goes into the root of the tree.
+var resourceTrees = []string{"brkitr", "coll", "curr", "lang", "rbnf", "region", "translit", "unit", "zone"}
+
+// overrideFile is a single binding entry and the path, relative to the data
+// directory of the icu-overrides layer, that it is installed to.
+type overrideFile struct {
+	Binding string
+	Entry   string
+	Path    string
+	Content []byte
+}
+
+// resolveOverrides collects the data files, resources and data filter
+// provided through bindings of type icu. Bindings are processed in name order
+// so that the result does not depend on the order they are mounted in.
+func resolveOverrides(bindingResolver BindingResolver, platformPath, version string) ([]overrideFile, string, error) {
+	bindings, err := bindingResolver.Resolve("icu", "", platformPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve icu binding: %w", err)
+	}
+
+	sort.Slice(bindings, func(i, j int) bool { return bindings[i].Name < bindings[j].Name })
+
+	tree := fmt.Sprintf("icudt%sl", majorVersion(version))
+	// the version is part of the digest since it determines where resources
+	// are installed and the ICU_DATA search order
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00", version)
+
+	var (
+		files   []overrideFile
+		filters int
+	)
+	for _, binding := range bindings {
+		var names []string
+		for name := range binding.Entries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			path, ok := overridePath(name, tree)
+			if !ok {
+				continue
+			}
+
+			content, err := binding.Entries[name].ReadBytes()
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to read entry %q of icu binding %q: %w", name, binding.Name, err)
+			}
+
+			if strings.HasSuffix(name, ".json") {
+				filters++
+			}
+
+			fmt.Fprintf(hash, "%s\x00%s\x00%d\x00", binding.Name, name, len(content))
+			hash.Write(content)
+
+			files = append(files, overrideFile{
+				Binding: binding.Name,
+				Entry:   name,
+				Path:    path,
+				Content: content,
+			})
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, "", nil
+	}
+
+	if filters > 1 {
+		return nil, "", fmt.Errorf("failed to resolve icu binding: expected at most 1 data filter but found %d", filters)
+	}
+
+	return files, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func overridePath(name, tree string) (string, bool) {
+	switch {
+	case overrideDataPattern.MatchString(name):
+		return name, true
+
+	case strings.HasSuffix(name, ".res"):
+		for _, resourceTree := range resourceTrees {
+			if resource, ok := strings.CutPrefix(name, resourceTree+"-"); ok {
+				return filepath.Join(tree, resourceTree, resource), true
+			}
+		}
+
+		return filepath.Join(tree, name), true
+
+	case strings.HasSuffix(name, ".json"):
+		return filepath.Join("filters", name), true
+	}
+
+	return "", false
+}
+
+// installOverrides merges the files supplied through icu bindings into the
+// icu-overrides layer and points ICU_DATA at it ahead of the data shipped
+// with the ICU dependency. The layer is reused while the content of the
+// bindings is unchanged.
+func installOverrides(context packit.BuildContext,
+	transaction *layerTransaction,
+	bindingResolver BindingResolver,
+	logger scribe.Emitter,
+	version string,
+	launch, build bool,
+) (packit.Layer, bool, error) {
+	files, digest, err := resolveOverrides(bindingResolver, context.Platform.Path, version)
+	if err != nil {
+		return packit.Layer{}, false, err
+	}
+
+	if len(files) == 0 {
+		return packit.Layer{}, false, removeLayer(context.Layers, ICUOverridesLayerName)
+	}
+
+	layer, err := context.Layers.Get(ICUOverridesLayerName)
+	if err != nil {
+		return packit.Layer{}, false, err
+	}
+
+	cachedDigest, ok := layer.Metadata["binding-digest"].(string)
+	if ok && cachedDigest == digest {
+		logger.Process("Reusing cached layer %s", layer.Path)
+		logger.Break()
+
+		layer.Launch, layer.Build, layer.Cache = launch, build, build
+
+		return layer, true, nil
+	}
+
+	logger.Process("Installing ICU data overrides")
+
+	layer, stagingPath, err := transaction.Stage(layer)
+	if err != nil {
+		return packit.Layer{}, false, err
+	}
+
+	layer.Launch, layer.Build, layer.Cache = launch, build, build
+
+	dataDir := filepath.Join(layer.Path, "data")
+	var filter string
+	for _, file := range files {
+		logger.Subprocess("Adding %s from binding %s", file.Path, file.Binding)
+
+		if match := overrideDataPattern.FindStringSubmatch(file.Entry); match != nil && match[1] != majorVersion(version) {
+			logger.Action("WARNING: %s does not match ICU %s and will be ignored by ICU", file.Entry, version)
+		}
+
+		destination := filepath.Join(stagingPath, "data", file.Path)
+		err = os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+		if err != nil {
+			return packit.Layer{}, false, err
+		}
+
+		err = os.WriteFile(destination, file.Content, 0644)
+		if err != nil {
+			return packit.Layer{}, false, err
+		}
+
+		if strings.HasSuffix(file.Entry, ".json") {
+			filter = filepath.Join(dataDir, file.Path)
+		}
+	}
+	logger.Break()
+
+	err = transaction.Commit(layer)
+	if err != nil {
+		return packit.Layer{}, false, err
+	}
+
+	// ICU searches the ICU_DATA directories in order, and loads individual
+	// resource files before falling back to the common data package
+	layer.SharedEnv.Override("ICU_DATA", strings.Join([]string{
+		dataDir,
+		filepath.Join(context.Layers.Path, ICULayerName, "share", "icu", version),
+	}, string(os.PathListSeparator)))
+
+	// a data filter can only be applied when ICU data is built from source,
+	// so it is made available to later buildpacks that do so
+	if filter != "" {
+		layer.BuildEnv.Override("ICU_DATA_FILTER_FILE", filter)
+	}
+
+	logger.EnvironmentVariables(layer)
+
+	layer.Metadata = map[string]interface{}{
+		"binding-digest": digest,
+	}
+
+	return layer, true, nil
+}
@@ -0,0 +1,195 @@
+package icu
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/Masterminds/semver/v3"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+const (
+	PolicyModeWarn = "warn"
+	PolicyModeFail = "fail"
+)
+
+// VersionPolicy is read from the [metadata.icu-policy] table of
+// buildpack.toml. Allow and Deny hold semver constraints; when Allow is
+// non-empty the selected version must satisfy at least one of them.
+type VersionPolicy struct {
+	Allow              []string            `toml:"allow"`
+	Deny               []string            `toml:"deny"`
+	VulnerableVersions []VulnerableVersion `toml:"vulnerable-versions"`
+}
+
+// VulnerableVersion records the CVEs that affect the ICU versions matching
+// the Versions constraint.
+type VulnerableVersion struct {
+	Versions string   `toml:"versions"`
+	CVEs     []string `toml:"cves"`
+}
+
+func parseVersionPolicy(path string) (VersionPolicy, error) {
+	var buildpack struct {
+		Metadata struct {
+			Policy VersionPolicy `toml:"icu-policy"`
+		} `toml:"metadata"`
+	}
+
+	_, err := toml.DecodeFile(path, &buildpack)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return VersionPolicy{}, nil
+		}
+
+		return VersionPolicy{}, fmt.Errorf("failed to parse ICU version policy: %w", err)
+	}
+
+	return buildpack.Metadata.Policy, nil
+}
+
+// Violations returns a description of every way in which the dependency
+// breaks the policy. A deprecation date that has passed is also reported.
+func (p VersionPolicy) Violations(dependency postal.Dependency, now time.Time) ([]string, error) {
+	var violations []string
+
+	if len(p.Allow) > 0 || len(p.Deny) > 0 || len(p.VulnerableVersions) > 0 {
+		version, err := semver.NewVersion(dependency.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate ICU version policy: %w", err)
+		}
+
+		for _, constraint := range p.Deny {
+			matches, err := satisfies(version, constraint)
+			if err != nil {
+				return nil, err
+			}
+
+			if matches {
+				violations = append(violations, fmt.Sprintf("version %s is denied by %q", dependency.Version, constraint))
+			}
+		}
+
+		if len(p.Allow) > 0 {
+			var allowed bool
+			for _, constraint := range p.Allow {
+				matches, err := satisfies(version, constraint)
+				if err != nil {
+					return nil, err
+				}
+
+				allowed = allowed || matches
+			}
+
+			if !allowed {
+				violations = append(violations, fmt.Sprintf("version %s is not in the allowed ranges %q", dependency.Version, strings.Join(p.Allow, ", ")))
+			}
+		}
+
+		for _, vulnerable := range p.VulnerableVersions {
+			matches, err := satisfies(version, vulnerable.Versions)
+			if err != nil {
+				return nil, err
+			}
+
+			if matches {
+				violations = append(violations, fmt.Sprintf("version %s is affected by %s", dependency.Version, strings.Join(vulnerable.CVEs, ", ")))
+			}
+		}
+	}
+
+	if !dependency.DeprecationDate.IsZero() && !now.Before(dependency.DeprecationDate) {
+		violations = append(violations, fmt.Sprintf("version %s was deprecated on %s", dependency.Version, dependency.DeprecationDate.Format("2006-01-02")))
+	}
+
+	return violations, nil
+}
+
+func satisfies(version *semver.Version, constraint string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse ICU version policy constraint %q: %w", constraint, err)
+	}
+
+	return c.Check(version), nil
+}
+
+// policyMode determines whether policy violations fail the build. The
+// BP_ICU_POLICY environment variable takes precedence over the "mode" entry
+// of an icu-policy binding; when neither is set violations are only logged.
+func policyMode(bindingResolver BindingResolver, platformPath string) (string, error) {
+	mode, ok := os.LookupEnv("BP_ICU_POLICY")
+	if !ok {
+		bindings, err := bindingResolver.Resolve("icu-policy", "", platformPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve icu-policy binding: %w", err)
+		}
+
+		if len(bindings) > 1 {
+			return "", fmt.Errorf("failed to resolve icu-policy binding: expected at most 1 binding but found %d", len(bindings))
+		}
+
+		if len(bindings) == 0 {
+			return PolicyModeWarn, nil
+		}
+
+		entry, ok := bindings[0].Entries["mode"]
+		if !ok {
+			return PolicyModeWarn, nil
+		}
+
+		mode, err = entry.ReadString()
+		if err != nil {
+			return "", fmt.Errorf("failed to read icu-policy binding: %w", err)
+		}
+	}
+
+	mode = strings.TrimSpace(mode)
+	switch mode {
+	case PolicyModeWarn, PolicyModeFail:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid ICU policy mode %q: must be %q or %q", mode, PolicyModeWarn, PolicyModeFail)
+	}
+}
+
+// enforcePolicy evaluates the version policy in buildpack.toml against the
+// resolved dependency and either logs the violations or returns them as an
+// error, depending on the policy mode.
+func enforcePolicy(bindingResolver BindingResolver, logger scribe.Emitter, buildpackTOMLPath, platformPath string, dependency postal.Dependency, now time.Time) error {
+	mode, err := policyMode(bindingResolver, platformPath)
+	if err != nil {
+		return err
+	}
+
+	policy, err := parseVersionPolicy(buildpackTOMLPath)
+	if err != nil {
+		return err
+	}
+
+	violations, err := policy.Violations(dependency, now)
+	if err != nil {
+		return err
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if mode == PolicyModeFail {
+		return fmt.Errorf("ICU %s violates the version policy:\n  %s", dependency.Version, strings.Join(violations, "\n  "))
+	}
+
+	logger.Subprocess("WARNING: ICU %s violates the version policy:", dependency.Version)
+	for _, violation := range violations {
+		logger.Action("%s", violation)
+	}
+	logger.Break()
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package icu
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/postal"
+)
+
+// The reasons recorded in the build report for reusing or rebuilding the ICU
+// layer.
+const (
+	reasonChecksumMatch   = "the dependency checksum matches the layer from the previous build"
+	reasonNoPreviousLayer = "there is no layer from a previous build"
+	reasonChecksumChanged = "the dependency checksum differs from the layer from the previous build"
+	reasonNodeDataChanged = "the Node.js ICU data requirement differs from the layer from the previous build"
+)
+
+// buildReport is the machine-readable summary of an ICU installation. It is
+// written as JSON to BP_ICU_REPORT_PATH when that variable is set. It is never
+// written into a layer, since its durations differ in every build and would
+// change the image digest.
+type buildReport struct {
+	Candidates []reportCandidate `json:"candidates"`
+	Dependency reportDependency  `json:"dependency"`
+	Layer      reportLayer       `json:"layer"`
+	Durations  reportDurations   `json:"durations"`
+}
+
+type reportCandidate struct {
+	VersionSource string `json:"version-source"`
+	Version       string `json:"version"`
+	Priority      int    `json:"priority"`
+}
+
+type reportDependency struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	URI      string `json:"uri"`
+	Checksum string `json:"checksum"`
+	CPE      string `json:"cpe"`
+	PURL     string `json:"purl"`
+	Custom   bool   `json:"custom"`
+}
+
+type reportLayer struct {
+	Name          string `json:"name"`
+	Reused        bool   `json:"reused"`
+	Reason        string `json:"reason"`
+	InstalledFrom string `json:"installed-from,omitempty"`
+	InstalledSize int64  `json:"installed-size"`
+}
+
+// reportDurations are given in seconds. Deliver and SBOM are zero when the
+// layer is reused.
+type reportDurations struct {
+	Resolve float64 `json:"resolve"`
+	Deliver float64 `json:"deliver"`
+	SBOM    float64 `json:"sbom"`
+}
+
+// newBuildReport lists the candidate version sources in the priority order of
+// the planner, without duplicates, along with the chosen dependency.
+func newBuildReport(entries []packit.BuildpackPlanEntry, dependency postal.Dependency, custom bool) buildReport {
+	report := buildReport{
+		Candidates: []reportCandidate{},
+		Dependency: reportDependency{
+			ID:       dependency.ID,
+			Name:     dependency.Name,
+			Version:  dependency.Version,
+			URI:      dependency.URI,
+			Checksum: dependency.Checksum,
+			CPE:      dependency.CPE,
+			PURL:     dependency.PURL,
+			Custom:   custom,
+		},
+		Layer: reportLayer{Name: ICULayerName},
+	}
+
+Entries:
+	for _, entry := range entries {
+		versionSource, ok := entry.Metadata["version-source"].(string)
+		if !ok {
+			versionSource = "<unknown>"
+		}
+
+		version, _ := entry.Metadata["version"].(string)
+
+		for _, candidate := range report.Candidates {
+			if candidate.VersionSource == versionSource && candidate.Version == version {
+				continue Entries
+			}
+		}
+
+		report.Candidates = append(report.Candidates, reportCandidate{
+			VersionSource: versionSource,
+			Version:       version,
+			Priority:      len(report.Candidates) + 1,
+		})
+	}
+
+	return report
+}
+
+// installedSize returns the total size of the regular files in the layer.
+func installedSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine the installed size: %w", err)
+	}
+
+	return size, nil
+}
+
+// writeReport writes the report to BP_ICU_REPORT_PATH, resolved against the
+// working directory, and returns the path it was written to. Nothing is
+// written when the variable is not set.
+func writeReport(context packit.BuildContext, report buildReport) (string, bool, error) {
+	path, ok := os.LookupEnv("BP_ICU_REPORT_PATH")
+	if !ok || path == "" {
+		return "", false, nil
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(context.WorkingDir, path)
+	}
+
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to marshal build report: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to write build report: %w", err)
+	}
+
+	err = os.WriteFile(path, content, 0644)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to write build report: %w", err)
+	}
+
+	return path, true, nil
+}
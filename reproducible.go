@@ -0,0 +1,97 @@
+package icu
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// defaultSourceDateEpoch is the modification time that the lifecycle also
+// gives to the files of exported layers, 1980-01-01T00:00:01Z.
+const defaultSourceDateEpoch = 315532801
+
+// sourceDateEpoch returns the time given by SOURCE_DATE_EPOCH, in seconds
+// since the Unix epoch, or the default timestamp of the lifecycle when it is
+// not set.
+func sourceDateEpoch() (time.Time, error) {
+	value, ok := os.LookupEnv("SOURCE_DATE_EPOCH")
+	if !ok || value == "" {
+		return time.Unix(defaultSourceDateEpoch, 0).UTC(), nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse SOURCE_DATE_EPOCH value %q: %w", value, err)
+	}
+
+	if seconds < 0 {
+		return time.Time{}, fmt.Errorf("failed to parse SOURCE_DATE_EPOCH value %q: must not be negative", value)
+	}
+
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// normalizeLayer makes the contents of a layer independent of when and how
+// they were extracted, so that the same ICU artifact always produces the same
+// layer digest. Entries are visited in lexical order; directories and
+// executable files get mode 0755 and all other files 0644, and every entry,
+// including symlinks, gets the given modification time. Ownership is left to
+// the lifecycle, which resets it when the layer is exported.
+func normalizeLayer(path string, timestamp time.Time) error {
+	times := []unix.Timespec{unix.NsecToTimespec(timestamp.UnixNano()), unix.NsecToTimespec(timestamp.UnixNano())}
+
+	var directories []string
+	err := filepath.WalkDir(path, func(entry string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			err = os.Chmod(entry, 0755)
+			if err != nil {
+				return err
+			}
+
+			// the times of directories are set once their contents are
+			// settled
+			directories = append(directories, entry)
+			return nil
+
+		case d.Type().IsRegular():
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			mode := os.FileMode(0644)
+			if info.Mode().Perm()&0111 != 0 {
+				mode = 0755
+			}
+
+			err = os.Chmod(entry, mode)
+			if err != nil {
+				return err
+			}
+		}
+
+		return unix.UtimesNanoAt(unix.AT_FDCWD, entry, times, unix.AT_SYMLINK_NOFOLLOW)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to normalize layer contents: %w", err)
+	}
+
+	for i := len(directories) - 1; i >= 0; i-- {
+		err = unix.UtimesNanoAt(unix.AT_FDCWD, directories[i], times, unix.AT_SYMLINK_NOFOLLOW)
+		if err != nil {
+			return fmt.Errorf("failed to normalize layer contents: %w", err)
+		}
+	}
+
+	return nil
+}
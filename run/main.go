@@ -7,9 +7,11 @@ import (
 	"github.com/paketo-buildpacks/packit/v2"
 	"github.com/paketo-buildpacks/packit/v2/cargo"
 	"github.com/paketo-buildpacks/packit/v2/chronos"
+	"github.com/paketo-buildpacks/packit/v2/pexec"
 	"github.com/paketo-buildpacks/packit/v2/postal"
 	"github.com/paketo-buildpacks/packit/v2/sbom"
 	"github.com/paketo-buildpacks/packit/v2/scribe"
+	"github.com/paketo-buildpacks/packit/v2/servicebindings"
 )
 
 type Generator struct{}
@@ -24,8 +26,12 @@ func main() {
 	packit.Run(
 		icu.Detect(),
 		icu.Build(
-			postal.NewService(cargo.NewTransport()),
+			icu.NewResumableDependencyManager(chronos.DefaultClock, logEmitter),
 			Generator{},
+			servicebindings.NewResolver(),
+			icu.NewOpenPGPDependencyVerifier(cargo.NewTransport()),
+			icu.NewManifestLayerPatcher(cargo.NewTransport(), servicebindings.NewResolver()),
+			pexec.NewExecutable("icupkg"),
 			chronos.DefaultClock,
 			logEmitter,
 		),
@@ -0,0 +1,75 @@
+package icu
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/paketo-buildpacks/packit/v2"
+	"github.com/paketo-buildpacks/packit/v2/scribe"
+)
+
+// staticRequested reports whether any plan entry asks for the static ICU
+// archives, which are needed by applications that link ICU statically.
+func staticRequested(entries []packit.BuildpackPlanEntry) bool {
+	for _, entry := range entries {
+		if static, ok := entry.Metadata["static"].(bool); ok && static {
+			return true
+		}
+	}
+
+	return false
+}
+
+// staticCompanion installs the static ICU archives into a build-only layer.
+// Static archives are never needed once the application has been linked, so
+// the layer is not exported into the image.
+func staticCompanion(logger scribe.Emitter) companion {
+	return companion{
+		DependencyID: ICUStaticDependency,
+		LayerName:    ICUStaticLayerName,
+		Name:         "ICU (static)",
+		Build:        true,
+		Configure: func(layer *packit.Layer, stagingPath string) error {
+			err := relocatePkgConfig(stagingPath, layer.Path)
+			if err != nil {
+				return err
+			}
+
+			layer.BuildEnv.Prepend("PKG_CONFIG_PATH", filepath.Join(layer.Path, "lib", "pkgconfig"), string(os.PathListSeparator))
+			logger.EnvironmentVariables(*layer)
+
+			return nil
+		},
+	}
+}
+
+// relocatePkgConfig rewrites the prefix of the pkg-config files below path to
+// the given prefix. The files are generated with the temporary install prefix
+// used during compilation, which does not exist on the build image.
+func relocatePkgConfig(path, prefix string) error {
+	files, err := filepath.Glob(filepath.Join(path, "lib", "pkgconfig", "*.pc"))
+	if err != nil {
+		return err
+	}
+
+	pattern := regexp.MustCompile(`(?m)^prefix\s*=.*$`)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(file, pattern.ReplaceAll(content, []byte("prefix = "+prefix)), info.Mode())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}